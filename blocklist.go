@@ -0,0 +1,154 @@
+package ip2location
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"strings"
+)
+
+// BlocklistFormat selects the output of WriteBlocklist
+type BlocklistFormat int
+
+const (
+	BlocklistNftables BlocklistFormat = iota // nftables sets, for nft -f
+	BlocklistIpset                           // hash:net sets, for ipset restore
+	BlocklistIptables                        // a chain dropping the addresses, for iptables-restore --noflush or ip6tables-restore --noflush
+)
+
+// BlocklistOptions configures WriteBlocklist
+type BlocklistOptions struct {
+	Countries []string // country codes, as in Record.CountryShort
+	Format    BlocklistFormat
+	Family    int    // 4 or 6; 0 writes both, except for BlocklistIptables
+	Name      string // table, set and chain name; sets get a "_v4" or "_v6" suffix. Default "geoblock"
+}
+
+// WriteBlocklist writes the addresses of the chosen countries, as merged CIDR prefixes, in a format
+// ready to be loaded by a firewall; a nil opts writes empty nftables sets.
+//
+// Every format replaces the addresses of an earlier load, and leaves everything else alone:
+// nftables and ipset sets are created if missing and flushed, and the iptables chain is flushed
+// when the file is loaded with --noflush; without it iptables-restore flushes the whole filter
+// table. Nothing refers to the sets or the chain: the caller adds the rules using them,
+// e.g. "iptables -I INPUT -j geoblock".
+func WriteBlocklist(w io.Writer, db *DB, opts *BlocklistOptions) error {
+	if opts == nil {
+		opts = &BlocklistOptions{}
+	}
+	name := opts.Name
+	if name == "" {
+		name = "geoblock"
+	}
+	families := []int{4, 6}
+	switch opts.Family {
+	case 0:
+		if opts.Format == BlocklistIptables {
+			return ErrInvalidFamily // iptables and ip6tables load separate files
+		}
+	case 4, 6:
+		families = []int{opts.Family}
+	default:
+		return ErrInvalidFamily
+	}
+	countries := make(map[string]bool)
+	for _, cc := range opts.Countries {
+		countries[strings.ToUpper(cc)] = true
+	}
+
+	var prefixes [2][]netip.Prefix
+	for i, family := range families {
+		var err error
+		if prefixes[i], err = db.countryprefixes(family, countries); err != nil {
+			return err
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	switch opts.Format {
+	case BlocklistNftables:
+		// flush the sets rather than the table, which also holds the rules using them
+		fmt.Fprintf(bw, "table inet %s {\n", name)
+		for _, family := range families {
+			fmt.Fprintf(bw, "\tset %s_v%d {\n\t\ttype ipv%d_addr\n\t\tflags interval\n\t}\n", name, family, family)
+		}
+		bw.WriteString("}\n")
+		for i, family := range families {
+			set := fmt.Sprintf("inet %s %s_v%d", name, name, family)
+			fmt.Fprintf(bw, "flush set %s\n", set)
+			if len(prefixes[i]) == 0 {
+				continue
+			}
+			fmt.Fprintf(bw, "add element %s {\n", set)
+			for j, p := range prefixes[i] {
+				if j < len(prefixes[i])-1 {
+					fmt.Fprintf(bw, "\t%s,\n", p)
+				} else {
+					fmt.Fprintf(bw, "\t%s\n", p)
+				}
+			}
+			bw.WriteString("}\n")
+		}
+	case BlocklistIpset:
+		for i, family := range families {
+			set, inet := fmt.Sprintf("%s_v%d", name, family), "inet"
+			if family == 6 {
+				inet = "inet6"
+			}
+			maxelem := 65536
+			if len(prefixes[i]) > maxelem {
+				maxelem = len(prefixes[i])
+			}
+			fmt.Fprintf(bw, "create %s hash:net family %s maxelem %d -exist\nflush %s\n", set, inet, maxelem, set)
+			for _, p := range prefixes[i] {
+				fmt.Fprintf(bw, "add %s %s\n", set, p)
+			}
+		}
+	case BlocklistIptables:
+		restore := "iptables-restore"
+		if families[0] == 6 {
+			restore = "ip6tables-restore"
+		}
+		fmt.Fprintf(bw, "# load with %s --noflush, and jump to the chain: -I INPUT -j %s\n", restore, name)
+		fmt.Fprintf(bw, "*filter\n:%s - [0:0]\n", name)
+		for _, p := range prefixes[0] {
+			fmt.Fprintf(bw, "-A %s -s %s -j DROP\n", name, p)
+		}
+		bw.WriteString("COMMIT\n")
+	default:
+		return ErrNotSupported
+	}
+	return bw.Flush()
+}
+
+// merged prefixes of the ranges of family in countries
+func (db *DB) countryprefixes(family int, countries map[string]bool) ([]netip.Prefix, error) {
+	if family == 6 && db.meta.ipv6databasecount == 0 {
+		return nil, nil
+	}
+	var prefixes []netip.Prefix
+	var cur Range
+	it := db.Ranges(family, ModeCountryShort)
+	for it.Next() {
+		r := it.Range()
+		if !countries[r.Record.CountryShort] {
+			continue
+		}
+		if cur.To != nil && cur.To.Cmp(r.From) == 0 {
+			cur.To = r.To
+			continue
+		}
+		if cur.To != nil {
+			prefixes = append(prefixes, cur.Prefixes()...)
+		}
+		cur = r
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	if cur.To != nil {
+		prefixes = append(prefixes, cur.Prefixes()...)
+	}
+	return prefixes, nil
+}
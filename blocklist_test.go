@@ -0,0 +1,68 @@
+package ip2location_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestWriteBlocklist(t *testing.T) {
+	db := ip2locationtest.MustNewDB(1, ip2locationtest.Table{
+		"1.0.0.0/24":    tokyo,
+		"1.0.1.0/24":    tokyo, // merged with the one before
+		"1.0.4.0/24":    paris,
+		"2001:db8::/32": tokyo,
+	})
+	defer db.Close()
+	for _, tt := range []struct {
+		name string
+		opts *ip2location.BlocklistOptions
+		want string
+	}{
+		{"nftables", &ip2location.BlocklistOptions{Countries: []string{"jp"}}, "" +
+			"table inet geoblock {\n" +
+			"\tset geoblock_v4 {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t}\n" +
+			"\tset geoblock_v6 {\n\t\ttype ipv6_addr\n\t\tflags interval\n\t}\n" +
+			"}\n" +
+			"flush set inet geoblock geoblock_v4\n" +
+			"add element inet geoblock geoblock_v4 {\n\t1.0.0.0/23\n}\n" +
+			"flush set inet geoblock geoblock_v6\n" +
+			"add element inet geoblock geoblock_v6 {\n\t2001:db8::/32\n}\n"},
+		{"nil options", nil, "" +
+			"table inet geoblock {\n" +
+			"\tset geoblock_v4 {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t}\n" +
+			"\tset geoblock_v6 {\n\t\ttype ipv6_addr\n\t\tflags interval\n\t}\n" +
+			"}\n" +
+			"flush set inet geoblock geoblock_v4\n" +
+			"flush set inet geoblock geoblock_v6\n"},
+		{"ipset", &ip2location.BlocklistOptions{Countries: []string{"JP", "FR"}, Format: ip2location.BlocklistIpset, Family: 4, Name: "bl"}, "" +
+			"create bl_v4 hash:net family inet maxelem 65536 -exist\n" +
+			"flush bl_v4\n" +
+			"add bl_v4 1.0.0.0/23\n" +
+			"add bl_v4 1.0.4.0/24\n"},
+		{"iptables", &ip2location.BlocklistOptions{Countries: []string{"JP"}, Format: ip2location.BlocklistIptables, Family: 6}, "" +
+			"# load with ip6tables-restore --noflush, and jump to the chain: -I INPUT -j geoblock\n" +
+			"*filter\n" +
+			":geoblock - [0:0]\n" +
+			"-A geoblock -s 2001:db8::/32 -j DROP\n" +
+			"COMMIT\n"},
+	} {
+		var buf bytes.Buffer
+		if err := ip2location.WriteBlocklist(&buf, db, tt.opts); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, buf.String(), tt.want)
+		}
+	}
+	for _, opts := range []*ip2location.BlocklistOptions{
+		{Format: ip2location.BlocklistIptables},
+		{Family: 5},
+	} {
+		if err := ip2location.WriteBlocklist(new(bytes.Buffer), db, opts); err != ip2location.ErrInvalidFamily {
+			t.Errorf("%+v: got %v, want ErrInvalidFamily", *opts, err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package ip2location
+
+import (
+	"math/big"
+	"net/netip"
+)
+
+// convert IP number of family to address
+func ipnumaddr(family int, ipnum *big.Int) netip.Addr {
+	var b [16]byte
+	if family == 4 {
+		ipnum.FillBytes(b[:4])
+		return netip.AddrFrom4([4]byte{b[0], b[1], b[2], b[3]})
+	}
+	ipnum.FillBytes(b[:])
+	return netip.AddrFrom16(b)
+}
+
+// split [from, to) into the fewest CIDR blocks
+func rangeprefixes(family int, from, to *big.Int) []netip.Prefix {
+	bits := 128
+	if family == 4 {
+		bits = 32
+	}
+	var prefixes []netip.Prefix
+	start := new(big.Int).Set(from)
+	size := new(big.Int)
+	end := new(big.Int)
+	for start.Cmp(to) < 0 {
+		// largest aligned block starting at start that does not pass to
+		n := bits
+		if start.Sign() != 0 && int(start.TrailingZeroBits()) < n {
+			n = int(start.TrailingZeroBits())
+		}
+		for ; n > 0; n-- {
+			size.Lsh(big.NewInt(1), uint(n))
+			if end.Add(start, size).Cmp(to) <= 0 {
+				break
+			}
+		}
+		size.Lsh(big.NewInt(1), uint(n))
+		prefixes = append(prefixes, netip.PrefixFrom(ipnumaddr(family, start), bits-n))
+		start.Add(start, size)
+	}
+	return prefixes
+}
+
+// RangePrefixes returns the fewest CIDR prefixes covering the addresses [from, to) of family 4 or 6,
+// where from and to are IP numbers as in Range
+func RangePrefixes(family int, from, to *big.Int) ([]netip.Prefix, error) {
+	i, end := familyindex(family)
+	if i < 0 {
+		return nil, ErrInvalidFamily
+	}
+	if from.Sign() < 0 || from.Cmp(to) > 0 || to.Cmp(end) > 0 {
+		return nil, ErrInvalidAddress
+	}
+	return rangeprefixes(family, from, to), nil
+}
+
+// AddrRangePrefixes returns the fewest CIDR prefixes covering the addresses from first to last inclusive
+func AddrRangePrefixes(first, last netip.Addr) ([]netip.Prefix, error) {
+	if !first.IsValid() || first.BitLen() != last.BitLen() || last.Less(first) {
+		return nil, ErrInvalidAddress
+	}
+	family := 6
+	if first.Is4() {
+		family = 4
+	}
+	to := new(big.Int).SetBytes(last.AsSlice())
+	return rangeprefixes(family, new(big.Int).SetBytes(first.AsSlice()), to.Add(to, big.NewInt(1))), nil
+}
+
+// Prefixes returns the fewest CIDR prefixes covering r
+func (r Range) Prefixes() []netip.Prefix { return rangeprefixes(r.Family, r.From, r.To) }
+
+// First returns the first address of r
+func (r Range) First() netip.Addr { return ipnumaddr(r.Family, r.From) }
+
+// Last returns the last address of r
+func (r Range) Last() netip.Addr { return ipnumaddr(r.Family, new(big.Int).Sub(r.To, big.NewInt(1))) }
+
+// MergeRanges merges adjacent ranges of the same family whose fields selected by mode are equal;
+// ranges must be in ascending order, as returned by RangeIterator. The records of merged ranges
+// are those of their first range.
+func MergeRanges(ranges []Range, mode uint32) []Range {
+	var merged []Range
+	for _, r := range ranges {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if last.Family == r.Family && last.To.Cmp(r.From) == 0 && samerecord(last.Record, r.Record, mode) {
+				last.To = r.To
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// PrefixRecord is a CIDR prefix and its record
+type PrefixRecord struct {
+	Prefix netip.Prefix
+	Record *Record
+}
+
+// Aggregate reads the remaining rows of it, merges adjacent rows whose fields selected by the mode
+// of it are equal, and returns the fewest CIDR prefixes of each merged range, in ascending order
+func Aggregate(it *RangeIterator) ([]PrefixRecord, error) {
+	var result []PrefixRecord
+	var cur Range
+	flush := func() {
+		if cur.To != nil {
+			for _, p := range cur.Prefixes() {
+				result = append(result, PrefixRecord{Prefix: p, Record: cur.Record})
+			}
+		}
+	}
+	for it.Next() {
+		r := it.Range()
+		if cur.To != nil && cur.To.Cmp(r.From) == 0 && samerecord(cur.Record, r.Record, it.mode) {
+			cur.To = r.To
+			continue
+		}
+		flush()
+		cur = r
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package ip2location_test
+
+import (
+	"errors"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestRangePrefixes(t *testing.T) {
+	for _, tt := range []struct {
+		family   int
+		from, to string
+		want     []string
+		err      error
+	}{
+		{4, "0", "256", []string{"0.0.0.0/24"}, nil},
+		{4, "1", "4", []string{"0.0.0.1/32", "0.0.0.2/31"}, nil},
+		{4, "16777216", "16777472", []string{"1.0.0.0/24"}, nil},
+		{4, "4294967040", "4294967296", []string{"255.255.255.0/24"}, nil},
+		{4, "0", "4294967296", []string{"0.0.0.0/0"}, nil},
+		{4, "5", "5", nil, nil},
+		{6, "0", "340282366920938463463374607431768211456", []string{"::/0"}, nil},
+		{6, "42540766411282592856903984951653826560", "42540766490510755371168322545197776896", []string{"2001:db8::/32"}, nil},
+		{4, "4", "1", nil, ip2location.ErrInvalidAddress},
+		{4, "0", "4294967297", nil, ip2location.ErrInvalidAddress},
+		{5, "0", "1", nil, ip2location.ErrInvalidFamily},
+	} {
+		from, _ := new(big.Int).SetString(tt.from, 10)
+		to, _ := new(big.Int).SetString(tt.to, 10)
+		prefixes, err := ip2location.RangePrefixes(tt.family, from, to)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%d [%s, %s): error %v, want %v", tt.family, tt.from, tt.to, err, tt.err)
+			continue
+		}
+		var got []string
+		for _, p := range prefixes {
+			got = append(got, p.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%d [%s, %s): got %v, want %v", tt.family, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestAddrRangePrefixes(t *testing.T) {
+	for _, tt := range []struct {
+		first, last string
+		want        []string
+		err         error
+	}{
+		{"10.0.0.0", "10.255.255.255", []string{"10.0.0.0/8"}, nil},
+		{"10.0.0.1", "10.0.0.6", []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"}, nil},
+		{"255.255.255.255", "255.255.255.255", []string{"255.255.255.255/32"}, nil},
+		{"::", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", []string{"::/0"}, nil},
+		{"10.0.0.2", "10.0.0.1", nil, ip2location.ErrInvalidAddress},
+		{"10.0.0.1", "::1", nil, ip2location.ErrInvalidAddress},
+	} {
+		prefixes, err := ip2location.AddrRangePrefixes(netip.MustParseAddr(tt.first), netip.MustParseAddr(tt.last))
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s-%s: error %v, want %v", tt.first, tt.last, err, tt.err)
+			continue
+		}
+		var got []string
+		for _, p := range prefixes {
+			got = append(got, p.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s-%s: got %v, want %v", tt.first, tt.last, got, tt.want)
+		}
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	db := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+		"1.0.0.0/25":   {CountryShort: "JP", City: "Tokyo"},
+		"1.0.0.128/25": {CountryShort: "JP", City: "Osaka"},
+		"1.0.1.0/24":   {CountryShort: "FR", City: "Paris"},
+	})
+	defer db.Close()
+	prefixes, err := ip2location.Aggregate(db.Ranges(4, ip2location.ModeCountryShort))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range prefixes {
+		if p.Record.CountryShort != "" {
+			got = append(got, p.Prefix.String()+" "+p.Record.CountryShort)
+		}
+	}
+	if want := []string{"1.0.0.0/24 JP", "1.0.1.0/24 FR"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+var blocklistCmd = &command{
+	name:  "blocklist",
+	args:  "<db.BIN>",
+	short: "write nftables, ipset or iptables rules blocking chosen countries",
+	flags: flag.NewFlagSet("blocklist", flag.ExitOnError),
+}
+
+var (
+	blocklistCountries = blocklistCmd.flags.String("countries", "", "comma-separated country codes to block, e.g. CN,RU")
+	blocklistFormat    = blocklistCmd.flags.String("format", "nft", "output format: nft, ipset or iptables; load iptables output with iptables-restore --noflush, and add the rules jumping to the chain or matching the sets")
+	blocklistFamily    = blocklistCmd.flags.Int("family", 0, "IP family, 4 or 6; 0 for both, except for iptables")
+	blocklistName      = blocklistCmd.flags.String("name", "geoblock", "table, set or chain name")
+)
+
+var blocklistFormats = map[string]ip2location.BlocklistFormat{
+	"nft":      ip2location.BlocklistNftables,
+	"ipset":    ip2location.BlocklistIpset,
+	"iptables": ip2location.BlocklistIptables,
+}
+
+func init() { blocklistCmd.run = runBlocklist }
+
+func runBlocklist(args []string) error {
+	if len(args) != 1 || *blocklistCountries == "" {
+		blocklistCmd.usage()
+	}
+	format, ok := blocklistFormats[*blocklistFormat]
+	if !ok {
+		return fmt.Errorf("unknown -format %q", *blocklistFormat)
+	}
+	db, err := ip2location.NewDB(args[0])
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return ip2location.WriteBlocklist(os.Stdout, db, &ip2location.BlocklistOptions{
+		Countries: strings.Split(*blocklistCountries, ","),
+		Format:    format,
+		Family:    *blocklistFamily,
+		Name:      *blocklistName,
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+var convertCmd = &command{
+	name:  "convert",
+	args:  "<src.BIN> <dst.BIN>",
+	short: "write a copy of a database keeping a subset of its fields",
+	flags: flag.NewFlagSet("convert", flag.ExitOnError),
+}
+
+var convertMode = convertCmd.flags.String("mode", "DB1", "fields to keep: a database type such as DB3, or CSV column names such as country_code,city_name")
+
+func init() { convertCmd.run = runConvert }
+
+func runConvert(args []string) error {
+	if len(args) != 2 {
+		convertCmd.usage()
+	}
+	mode, err := ip2location.ParseMode(*convertMode)
+	if err != nil {
+		return err
+	}
+	src, err := ip2location.NewDB(args[0])
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	return writefile(args[1], func(f *os.File) error {
+		_, err := ip2location.Convert(f, src, mode)
+		return err
+	})
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+var diffCmd = &command{
+	name:  "diff",
+	args:  "<old.BIN> <new.BIN>",
+	short: "report the ranges whose fields changed between two versions of a database",
+	flags: flag.NewFlagSet("diff", flag.ExitOnError),
+}
+
+var diffMode = diffCmd.flags.String("mode", "DB1", "fields to compare: a database type such as DB3, or CSV column names such as country_code,city_name")
+
+func init() { diffCmd.run = runDiff }
+
+// The report has a line for each changed field of each range, tab-separated:
+//
+//	<first address>-<last address>	<field>	<old value>	<new value>
+//
+// followed by the changed ranges and addresses by country.
+func runDiff(args []string) error {
+	if len(args) != 2 {
+		diffCmd.usage()
+	}
+	mode, err := ip2location.ParseMode(*diffMode)
+	if err != nil {
+		return err
+	}
+	older, err := ip2location.NewDB(args[0])
+	if err != nil {
+		return err
+	}
+	defer older.Close()
+	newer, err := ip2location.NewDB(args[1])
+	if err != nil {
+		return err
+	}
+	defer newer.Close()
+
+	changes, err := ip2location.Diff(older, newer, mode)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(os.Stdout)
+	fmt.Fprintf(w, "# old: %s (DB%d, %s)\n", args[0], older.Metadata().Type, older.Metadata().Date.Format("2006-01-02"))
+	fmt.Fprintf(w, "# new: %s (DB%d, %s)\n", args[1], newer.Metadata().Type, newer.Metadata().Date.Format("2006-01-02"))
+	for _, c := range changes {
+		r := ip2location.Range{Family: c.Family, From: c.From, To: c.To}
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "%s-%s\t%s\t%s\t%s\n", r.First(), r.Last(), f.Name, f.Old, f.New)
+		}
+	}
+
+	summary := ip2location.SummarizeChanges(changes)
+	countries := make([]string, 0, len(summary))
+	for cc := range summary {
+		countries = append(countries, cc)
+	}
+	sort.Strings(countries)
+	fmt.Fprintf(w, "# %d changed ranges\n# country\tranges\taddresses\n", len(changes))
+	for _, cc := range countries {
+		name := cc
+		if name == "" {
+			name = "(none)"
+		}
+		fmt.Fprintf(w, "# %s\t%d\t%s\n", name, summary[cc].Ranges, summary[cc].Addresses)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+var extractCmd = &command{
+	name:  "extract",
+	args:  "<src.BIN> <dst.BIN>",
+	short: "write a copy of a database keeping full detail only for chosen countries or regions",
+	flags: flag.NewFlagSet("extract", flag.ExitOnError),
+}
+
+var (
+	extractCountries = extractCmd.flags.String("countries", "", "comma-separated country codes to keep, e.g. DE,FR")
+	extractRegions   regionsFlag
+	extractOther     = extractCmd.flags.String("other", "placeholder", `other ranges: "placeholder" or "country" to keep the country only`)
+)
+
+func init() {
+	extractCmd.run = runExtract
+	extractCmd.flags.Var(&extractRegions, "region", "region to keep, as CC:Region name, e.g. US:California; may be repeated")
+}
+
+// regionsFlag collects -region values by country code
+type regionsFlag map[string][]string
+
+func (f *regionsFlag) String() string { return "" }
+
+func (f *regionsFlag) Set(s string) error {
+	i := strings.IndexByte(s, ':')
+	if i <= 0 {
+		return fmt.Errorf("region %q is not of the form CC:Region name", s)
+	}
+	if *f == nil {
+		*f = make(regionsFlag)
+	}
+	(*f)[s[:i]] = append((*f)[s[:i]], s[i+1:])
+	return nil
+}
+
+func runExtract(args []string) error {
+	if len(args) != 2 {
+		extractCmd.usage()
+	}
+	opts := &ip2location.ExtractOptions{Regions: extractRegions}
+	if *extractCountries != "" {
+		opts.Countries = strings.Split(*extractCountries, ",")
+	}
+	switch *extractOther {
+	case "placeholder":
+		opts.Other = ip2location.ExtractPlaceholder
+	case "country":
+		opts.Other = ip2location.ExtractCountryOnly
+	default:
+		return fmt.Errorf("unknown -other %q", *extractOther)
+	}
+	src, err := ip2location.NewDB(args[0])
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	return writefile(args[1], func(f *os.File) error {
+		_, err := ip2location.Extract(f, src, opts)
+		return err
+	})
+}
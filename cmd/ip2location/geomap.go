@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+var geomapCmd = &command{
+	name:  "geomap",
+	args:  "<db.BIN>",
+	short: "write a field of every range as an nginx geo, HAProxy map_ip or Apache map",
+	flags: flag.NewFlagSet("geomap", flag.ExitOnError),
+}
+
+var (
+	geomapField  = geomapCmd.flags.String("field", "country_code", "CSV column name of the field, e.g. country_code or region_name")
+	geomapFormat = geomapCmd.flags.String("format", "nginx", "output format: nginx, haproxy or apache")
+	geomapFamily = geomapCmd.flags.Int("family", 0, "IP family, 4 or 6; 0 for both")
+	geomapName   = geomapCmd.flags.String("name", "GEO", "environment variable set by the apache format")
+)
+
+var geomapFormats = map[string]ip2location.GeoMapFormat{
+	"nginx":   ip2location.GeoMapNginx,
+	"haproxy": ip2location.GeoMapHAProxy,
+	"apache":  ip2location.GeoMapApache,
+}
+
+func init() { geomapCmd.run = runGeomap }
+
+func runGeomap(args []string) error {
+	if len(args) != 1 {
+		geomapCmd.usage()
+	}
+	format, ok := geomapFormats[*geomapFormat]
+	if !ok {
+		return fmt.Errorf("unknown -format %q", *geomapFormat)
+	}
+	field, err := ip2location.ParseMode(*geomapField)
+	if err != nil {
+		return err
+	}
+	db, err := ip2location.NewDB(args[0])
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return ip2location.WriteGeoMap(os.Stdout, db, &ip2location.GeoMapOptions{
+		Field:  field,
+		Format: format,
+		Family: *geomapFamily,
+		Name:   *geomapName,
+	})
+}
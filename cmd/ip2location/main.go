@@ -0,0 +1,84 @@
+// Command ip2location works with IP2Location BIN database files.
+//
+// Usage:
+//
+//	ip2location <command> [flags] [arguments]
+//
+// Run "ip2location help <command>" for the flags of a command.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name  string
+	args  string // usage after the command name and flags
+	short string
+	flags *flag.FlagSet
+	run   func(args []string) error
+}
+
+var commands = []*command{
+	convertCmd,
+	extractCmd,
+	diffCmd,
+	blocklistCmd,
+	geomapCmd,
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: ip2location <command> [flags] [arguments]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.short)
+	}
+	os.Exit(2)
+}
+
+func (c *command) usage() {
+	fmt.Fprintf(os.Stderr, "usage: ip2location %s [flags] %s\n\n%s\n", c.name, c.args, c.short)
+	c.flags.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	name, args := os.Args[1], os.Args[2:]
+	if name == "help" && len(args) == 1 {
+		name, args = args[0], []string{"-h"}
+	}
+	for _, c := range commands {
+		if c.name != name {
+			continue
+		}
+		c.flags.Usage = c.usage
+		c.flags.Parse(args)
+		if err := c.run(c.flags.Args()); err != nil {
+			fmt.Fprintf(os.Stderr, "ip2location %s: %v\n", c.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+	usage()
+}
+
+// create path and write it with fn, removing it on failure
+func writefile(path string, fn func(f *os.File) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = fn(f); err == nil {
+		err = f.Close()
+	} else {
+		f.Close()
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+	return err
+}
@@ -0,0 +1,33 @@
+package ip2location
+
+import (
+	"io"
+)
+
+// Convert writes a copy of src keeping only the fields of mode, e.g. ModeDB3.
+// The database type of the copy is the smallest one carrying the remaining
+// fields (see DBType), and every column of that type that src has is copied,
+// so ModeCountryShort also keeps the country name of DB1. Adjacent ranges
+// that become identical are merged. Convert returns ErrNotSupported if src
+// has none of the fields of mode.
+func Convert(w io.Writer, src *DB, mode uint32) (int64, error) {
+	mode &= dbmode(src.meta.databasetype)
+	dbt, ok := DBType(mode)
+	if !ok || mode == 0 {
+		return 0, ErrNotSupported
+	}
+	mode = dbmode(dbt) & dbmode(src.meta.databasetype)
+	dst, err := NewWriter(dbt, src.Metadata().Date)
+	if err != nil {
+		return 0, err
+	}
+	if err = dst.AddRanges(src.Ranges(4, mode)); err != nil {
+		return 0, err
+	}
+	if src.meta.ipv6databasecount > 0 {
+		if err = dst.AddRanges(src.Ranges(6, mode)); err != nil {
+			return 0, err
+		}
+	}
+	return dst.WriteTo(w)
+}
@@ -0,0 +1,57 @@
+package ip2location_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestConvert(t *testing.T) {
+	src := ip2locationtest.MustNewDB(26, table)
+	defer src.Close()
+	for _, tt := range []struct {
+		mode uint32
+		dbt  uint8
+	}{
+		{ip2location.ModeCountryShort, 1},
+		{ip2location.ModeCity, 3},
+		{ip2location.ModeLatitude | ip2location.ModeISP, 6},
+		{ip2location.ModeDistrict, 26},
+	} {
+		var buf bytes.Buffer
+		if _, err := ip2location.Convert(&buf, src, tt.mode); err != nil {
+			t.Fatal(err)
+		}
+		db, err := ip2location.NewDBFromReader(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := db.Metadata().Type; got != tt.dbt {
+			t.Errorf("mode %#x: DB%d, want DB%d", tt.mode, got, tt.dbt)
+		}
+		mode, _ := ip2location.ParseMode(fmt.Sprintf("DB%d", tt.dbt))
+		r, err := db.GetAll("1.0.0.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := lookedup(tokyo, mode); *r != want {
+			t.Errorf("mode %#x:\n got %+v\nwant %+v", tt.mode, *r, want)
+		}
+	}
+}
+
+func TestConvertMissingFields(t *testing.T) {
+	src := ip2locationtest.MustNewDB(3, table)
+	defer src.Close()
+	var buf bytes.Buffer
+	if _, err := ip2location.Convert(&buf, src, ip2location.ModeDistrict); !errors.Is(err, ip2location.ErrNotSupported) {
+		t.Errorf("district of DB3: error %v, want %v", err, ip2location.ErrNotSupported)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("district of DB3: wrote %d bytes", buf.Len())
+	}
+}
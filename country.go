@@ -0,0 +1,77 @@
+package ip2location
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:generate go run -C gen . -o ../countrydata.go countries
+
+var ErrIddCodeMismatch = errors.New("IDD code does not match the country")
+
+// Country is reference data of a country or territory, keyed by its ISO 3166-1 alpha-2 code
+type Country struct {
+	Code         string   // ISO 3166-1 alpha-2, as in Record.CountryShort
+	Alpha3       string   // ISO 3166-1 alpha-3
+	Numeric      string   // ISO 3166-1 numeric, 3 digits; empty for Kosovo
+	Name         string   // English short name
+	Continent    string   // AF, AN, AS, EU, NA, OC or SA
+	EU           bool     // member of the European Union
+	EEA          bool     // member of the European Economic Area
+	Currency     string   // ISO 4217 code of the default currency
+	Languages    []string // ISO 639 codes, the main language first, then others with a CLDR locale for the country
+	CallingCodes []string // ITU calling codes with the area prefix where one is needed, e.g. "1876" for Jamaica
+}
+
+// LookupCountry returns the reference data of an ISO 3166-1 alpha-2 code such as "US";
+// the slices of the result are shared and must not be modified
+func LookupCountry(code string) (Country, bool) {
+	c, ok := countrytable[strings.ToUpper(code)]
+	return c, ok
+}
+
+// Country returns the reference data of r.CountryShort; false for "-", empty or unknown codes
+func (r *Record) Country() (Country, bool) { return LookupCountry(r.CountryShort) }
+
+// HasCallingCode reports whether idd, such as an IddCode of "44" or "1876", dials c: it starts with one
+// of c.CallingCodes, or is "1" for a country of the North American Numbering Plan. The area codes of
+// NANP territories with their own country code, such as "1876" for Jamaica, do not dial the US or Canada.
+func (c Country) HasCallingCode(idd string) bool {
+	idd = strings.TrimPrefix(strings.TrimSpace(idd), "+")
+	if idd == "" {
+		return false
+	}
+	for _, code := range c.CallingCodes {
+		if idd == "1" && code[0] == '1' {
+			return true
+		}
+		if strings.HasPrefix(idd, code) && !(code == "1" && len(idd) >= 4 && nanpareas[idd[:4]]) {
+			return true
+		}
+	}
+	return false
+}
+
+// calling codes of NANP territories with an area prefix, e.g. "1876"
+var nanpareas = func() map[string]bool {
+	areas := make(map[string]bool)
+	for _, c := range countrytable {
+		for _, code := range c.CallingCodes {
+			if len(code) == 4 && code[0] == '1' {
+				areas[code] = true
+			}
+		}
+	}
+	return areas
+}()
+
+// CheckIddCode cross-checks r.IddCode with the calling codes of r's country; it returns
+// ErrIddCodeMismatch if they disagree, and nil if either is unknown
+func (r *Record) CheckIddCode() error {
+	c, ok := r.Country()
+	if !ok || placeholder(r.IddCode) || c.HasCallingCode(r.IddCode) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is %s, not %q", ErrIddCodeMismatch, c.Code, strings.Join(c.CallingCodes, "/"), r.IddCode)
+}
@@ -0,0 +1,85 @@
+package ip2location_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+func TestLookupCountry(t *testing.T) {
+	for _, tt := range []struct {
+		code, alpha3, currency string
+		eu, ok                 bool
+	}{
+		{"FR", "FRA", "EUR", true, true},
+		{"jp", "JPN", "JPY", false, true},
+		{"BG", "BGR", "EUR", true, true},
+		{"CW", "CUW", "XCG", false, true},
+		{"-", "", "", false, false},
+		{"", "", "", false, false},
+		{"ZZ", "", "", false, false},
+	} {
+		c, ok := ip2location.LookupCountry(tt.code)
+		if ok != tt.ok || c.Alpha3 != tt.alpha3 || c.Currency != tt.currency || c.EU != tt.eu {
+			t.Errorf("%q: got %s %s EU %v, %v, want %s %s EU %v, %v", tt.code, c.Alpha3, c.Currency, c.EU, ok, tt.alpha3, tt.currency, tt.eu, tt.ok)
+		}
+	}
+}
+
+func TestHasCallingCode(t *testing.T) {
+	for _, tt := range []struct {
+		country, idd string
+		want         bool
+	}{
+		{"US", "1", true},
+		{"CA", "1", true},
+		{"JM", "1", true}, // NANP countries may record the shared code
+		{"JM", "1876", true},
+		{"JM", "1658", true},
+		{"PR", "1787", true},
+		{"US", "1876", false},
+		{"CA", "1787", false},
+		{"US", "1212", true}, // a US area code
+		{"JM", "1787", false},
+		{"JM", "44", false},
+		{"GB", "44", true},
+		{"GG", "44", true},
+		{"GG", "441481", true},
+		{"GB", "+44", true},
+		{"VA", "379", true},
+		{"VA", "39", true},
+		{"FR", "33", true},
+		{"FR", "34", false},
+		{"FR", "", false},
+		{"KZ", "7", true},
+	} {
+		c, ok := ip2location.LookupCountry(tt.country)
+		if !ok {
+			t.Fatalf("%s: not found", tt.country)
+		}
+		if got := c.HasCallingCode(tt.idd); got != tt.want {
+			t.Errorf("%s %q: got %v, want %v", tt.country, tt.idd, got, tt.want)
+		}
+	}
+}
+
+func TestCheckIddCode(t *testing.T) {
+	for _, tt := range []struct {
+		country, idd string
+		err          error
+	}{
+		{"JM", "1876", nil},
+		{"US", "1", nil},
+		{"US", "1876", ip2location.ErrIddCodeMismatch},
+		{"FR", "44", ip2location.ErrIddCodeMismatch},
+		{"FR", "-", nil},
+		{"-", "44", nil},
+		{"ZZ", "44", nil},
+	} {
+		r := ip2location.Record{CountryShort: tt.country, IddCode: tt.idd}
+		if err := r.CheckIddCode(); !errors.Is(err, tt.err) {
+			t.Errorf("%s %q: got %v, want %v", tt.country, tt.idd, err, tt.err)
+		}
+	}
+}
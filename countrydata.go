@@ -0,0 +1,257 @@
+// Code generated by gen from ISO 3166-1 (iso-codes), CLDR and ITU calling codes; DO NOT EDIT.
+
+package ip2location
+
+// reference data of countries by ISO 3166-1 alpha-2 code
+var countrytable = map[string]Country{
+	"AD": {"AD", "AND", "020", "Andorra", "EU", false, false, "EUR", []string{"ca"}, []string{"376"}},
+	"AE": {"AE", "ARE", "784", "United Arab Emirates", "AS", false, false, "AED", []string{"ar"}, []string{"971"}},
+	"AF": {"AF", "AFG", "004", "Afghanistan", "AS", false, false, "AFN", []string{"fa", "ps"}, []string{"93"}},
+	"AG": {"AG", "ATG", "028", "Antigua and Barbuda", "NA", false, false, "XCD", []string{"en"}, []string{"1268"}},
+	"AI": {"AI", "AIA", "660", "Anguilla", "NA", false, false, "XCD", []string{"en"}, []string{"1264"}},
+	"AL": {"AL", "ALB", "008", "Albania", "EU", false, false, "ALL", []string{"sq"}, []string{"355"}},
+	"AM": {"AM", "ARM", "051", "Armenia", "AS", false, false, "AMD", []string{"hy"}, []string{"374"}},
+	"AO": {"AO", "AGO", "024", "Angola", "AF", false, false, "AOA", []string{"pt", "ln"}, []string{"244"}},
+	"AQ": {"AQ", "ATA", "010", "Antarctica", "AN", false, false, "", nil, []string{"672"}},
+	"AR": {"AR", "ARG", "032", "Argentina", "SA", false, false, "ARS", []string{"es"}, []string{"54"}},
+	"AS": {"AS", "ASM", "016", "American Samoa", "OC", false, false, "USD", []string{"sm"}, []string{"1684"}},
+	"AT": {"AT", "AUT", "040", "Austria", "EU", true, true, "EUR", []string{"de"}, []string{"43"}},
+	"AU": {"AU", "AUS", "036", "Australia", "OC", false, false, "AUD", []string{"en"}, []string{"61"}},
+	"AW": {"AW", "ABW", "533", "Aruba", "NA", false, false, "AWG", []string{"nl"}, []string{"297", "5998"}},
+	"AX": {"AX", "ALA", "248", "Åland Islands", "EU", false, false, "EUR", []string{"sv"}, []string{"358", "35818"}},
+	"AZ": {"AZ", "AZE", "031", "Azerbaijan", "AS", false, false, "AZN", []string{"az"}, []string{"994"}},
+	"BA": {"BA", "BIH", "070", "Bosnia and Herzegovina", "EU", false, false, "BAM", []string{"bs", "hr"}, []string{"387"}},
+	"BB": {"BB", "BRB", "052", "Barbados", "NA", false, false, "BBD", []string{"en"}, []string{"1246"}},
+	"BD": {"BD", "BGD", "050", "Bangladesh", "AS", false, false, "BDT", []string{"bn", "ccp"}, []string{"880"}},
+	"BE": {"BE", "BEL", "056", "Belgium", "EU", true, true, "EUR", []string{"nl", "de", "fr"}, []string{"32"}},
+	"BF": {"BF", "BFA", "854", "Burkina Faso", "AF", false, false, "XOF", []string{"fr"}, []string{"226"}},
+	"BG": {"BG", "BGR", "100", "Bulgaria", "EU", true, true, "EUR", []string{"bg"}, []string{"359"}},
+	"BH": {"BH", "BHR", "048", "Bahrain", "AS", false, false, "BHD", []string{"ar"}, []string{"973"}},
+	"BI": {"BI", "BDI", "108", "Burundi", "AF", false, false, "BIF", []string{"rn", "fr"}, []string{"257"}},
+	"BJ": {"BJ", "BEN", "204", "Benin", "AF", false, false, "XOF", []string{"fr", "yo"}, []string{"229"}},
+	"BL": {"BL", "BLM", "652", "Saint Barthélemy", "NA", false, false, "EUR", []string{"fr"}, []string{"590"}},
+	"BM": {"BM", "BMU", "060", "Bermuda", "NA", false, false, "BMD", []string{"en"}, []string{"1441"}},
+	"BN": {"BN", "BRN", "096", "Brunei Darussalam", "AS", false, false, "BND", []string{"ms"}, []string{"673"}},
+	"BO": {"BO", "BOL", "068", "Bolivia", "SA", false, false, "BOB", []string{"es", "qu"}, []string{"591"}},
+	"BQ": {"BQ", "BES", "535", "Bonaire, Sint Eustatius and Saba", "NA", false, false, "USD", []string{"pap", "nl"}, []string{"5993", "5994"}},
+	"BR": {"BR", "BRA", "076", "Brazil", "SA", false, false, "BRL", []string{"pt", "es"}, []string{"55"}},
+	"BS": {"BS", "BHS", "044", "Bahamas", "NA", false, false, "BSD", []string{"en"}, []string{"1242"}},
+	"BT": {"BT", "BTN", "064", "Bhutan", "AS", false, false, "BTN", []string{"dz"}, []string{"975"}},
+	"BV": {"BV", "BVT", "074", "Bouvet Island", "OC", false, false, "NOK", nil, []string{"47"}},
+	"BW": {"BW", "BWA", "072", "Botswana", "AF", false, false, "BWP", []string{"en"}, []string{"267"}},
+	"BY": {"BY", "BLR", "112", "Belarus", "EU", false, false, "BYN", []string{"be", "ru"}, []string{"375"}},
+	"BZ": {"BZ", "BLZ", "084", "Belize", "NA", false, false, "BZD", []string{"en", "es"}, []string{"501"}},
+	"CA": {"CA", "CAN", "124", "Canada", "NA", false, false, "CAD", []string{"en", "fr"}, []string{"1"}},
+	"CC": {"CC", "CCK", "166", "Cocos (Keeling) Islands", "OC", false, false, "AUD", []string{"en"}, []string{"672", "6189162"}},
+	"CD": {"CD", "COD", "180", "Congo, The Democratic Republic of the", "AF", false, false, "CDF", []string{"sw", "fr", "ln", "lu"}, []string{"243"}},
+	"CF": {"CF", "CAF", "140", "Central African Republic", "AF", false, false, "XAF", []string{"fr", "ln", "sg"}, []string{"236"}},
+	"CG": {"CG", "COG", "178", "Congo", "AF", false, false, "XAF", []string{"fr", "ln"}, []string{"242"}},
+	"CH": {"CH", "CHE", "756", "Switzerland", "EU", false, false, "CHF", []string{"de", "fr", "gsw", "it", "pt", "rm", "wae"}, []string{"41"}},
+	"CI": {"CI", "CIV", "384", "Côte d'Ivoire", "AF", false, false, "XOF", []string{"fr"}, []string{"225"}},
+	"CK": {"CK", "COK", "184", "Cook Islands", "OC", false, false, "NZD", []string{"en"}, []string{"682"}},
+	"CL": {"CL", "CHL", "152", "Chile", "SA", false, false, "CLP", []string{"es"}, []string{"56"}},
+	"CM": {"CM", "CMR", "120", "Cameroon", "AF", false, false, "XAF", []string{"fr", "agq", "bas", "dua", "ewo", "ff", "jgo", "kkj", "ksf", "mgo", "mua", "nmg", "nnh", "yav"}, []string{"237"}},
+	"CN": {"CN", "CHN", "156", "China", "AS", false, false, "CNY", []string{"zh", "bo", "ii", "ug"}, []string{"86"}},
+	"CO": {"CO", "COL", "170", "Colombia", "SA", false, false, "COP", []string{"es"}, []string{"57"}},
+	"CR": {"CR", "CRI", "188", "Costa Rica", "NA", false, false, "CRC", []string{"es"}, []string{"506"}},
+	"CU": {"CU", "CUB", "192", "Cuba", "NA", false, false, "CUP", []string{"es"}, []string{"53"}},
+	"CV": {"CV", "CPV", "132", "Cabo Verde", "AF", false, false, "CVE", []string{"pt", "kea"}, []string{"238"}},
+	"CW": {"CW", "CUW", "531", "Curaçao", "NA", false, false, "XCG", []string{"pap", "nl"}, []string{"5999"}},
+	"CX": {"CX", "CXR", "162", "Christmas Island", "OC", false, false, "AUD", []string{"en"}, []string{"61", "6189164"}},
+	"CY": {"CY", "CYP", "196", "Cyprus", "AS", true, true, "EUR", []string{"el", "tr"}, []string{"357"}},
+	"CZ": {"CZ", "CZE", "203", "Czechia", "EU", true, true, "CZK", []string{"cs"}, []string{"420"}},
+	"DE": {"DE", "DEU", "276", "Germany", "EU", true, true, "EUR", []string{"de", "dsb", "hsb", "ksh", "nds"}, []string{"49"}},
+	"DJ": {"DJ", "DJI", "262", "Djibouti", "AF", false, false, "DJF", []string{"aa", "ar", "fr", "so"}, []string{"253"}},
+	"DK": {"DK", "DNK", "208", "Denmark", "EU", true, true, "DKK", []string{"da", "fo"}, []string{"45"}},
+	"DM": {"DM", "DMA", "212", "Dominica", "NA", false, false, "XCD", []string{"en"}, []string{"1767"}},
+	"DO": {"DO", "DOM", "214", "Dominican Republic", "NA", false, false, "DOP", []string{"es"}, []string{"1809", "1829", "1849"}},
+	"DZ": {"DZ", "DZA", "012", "Algeria", "AF", false, false, "DZD", []string{"ar", "fr", "kab"}, []string{"213"}},
+	"EC": {"EC", "ECU", "218", "Ecuador", "SA", false, false, "USD", []string{"es", "qu"}, []string{"593"}},
+	"EE": {"EE", "EST", "233", "Estonia", "EU", true, true, "EUR", []string{"et"}, []string{"372"}},
+	"EG": {"EG", "EGY", "818", "Egypt", "AF", false, false, "EGP", []string{"ar"}, []string{"20"}},
+	"EH": {"EH", "ESH", "732", "Western Sahara", "AF", false, false, "MAD", []string{"ar"}, []string{"212"}},
+	"ER": {"ER", "ERI", "232", "Eritrea", "AF", false, false, "ERN", []string{"ti", "ar"}, []string{"291"}},
+	"ES": {"ES", "ESP", "724", "Spain", "EU", true, true, "EUR", []string{"es", "ast", "ca", "eu", "gl"}, []string{"34"}},
+	"ET": {"ET", "ETH", "231", "Ethiopia", "AF", false, false, "ETB", []string{"am", "om", "so", "ti"}, []string{"251"}},
+	"FI": {"FI", "FIN", "246", "Finland", "EU", true, true, "EUR", []string{"fi", "se", "smn", "sv"}, []string{"358"}},
+	"FJ": {"FJ", "FJI", "242", "Fiji", "OC", false, false, "FJD", []string{"en"}, []string{"679"}},
+	"FK": {"FK", "FLK", "238", "Falkland Islands (Malvinas)", "SA", false, false, "FKP", []string{"en"}, []string{"500"}},
+	"FM": {"FM", "FSM", "583", "Micronesia, Federated States of", "OC", false, false, "USD", []string{"en"}, []string{"691"}},
+	"FO": {"FO", "FRO", "234", "Faroe Islands", "EU", false, false, "DKK", []string{"fo"}, []string{"298"}},
+	"FR": {"FR", "FRA", "250", "France", "EU", true, true, "EUR", []string{"fr", "br", "ca", "gsw"}, []string{"33"}},
+	"GA": {"GA", "GAB", "266", "Gabon", "AF", false, false, "XAF", []string{"fr"}, []string{"241"}},
+	"GB": {"GB", "GBR", "826", "United Kingdom", "EU", false, false, "GBP", []string{"en", "cy", "ga", "gd", "kw"}, []string{"44"}},
+	"GD": {"GD", "GRD", "308", "Grenada", "NA", false, false, "XCD", []string{"en"}, []string{"1473"}},
+	"GE": {"GE", "GEO", "268", "Georgia", "AS", false, false, "GEL", []string{"ka", "os"}, []string{"995"}},
+	"GF": {"GF", "GUF", "254", "French Guiana", "SA", false, false, "EUR", []string{"fr"}, []string{"594"}},
+	"GG": {"GG", "GGY", "831", "Guernsey", "EU", false, false, "GBP", []string{"en"}, []string{"44", "441481"}},
+	"GH": {"GH", "GHA", "288", "Ghana", "AF", false, false, "GHS", []string{"ak", "ee", "ha"}, []string{"233"}},
+	"GI": {"GI", "GIB", "292", "Gibraltar", "EU", false, false, "GIP", []string{"en"}, []string{"350"}},
+	"GL": {"GL", "GRL", "304", "Greenland", "NA", false, false, "DKK", []string{"kl", "da"}, []string{"299"}},
+	"GM": {"GM", "GMB", "270", "Gambia", "AF", false, false, "GMD", []string{"en"}, []string{"220"}},
+	"GN": {"GN", "GIN", "324", "Guinea", "AF", false, false, "GNF", []string{"fr", "ff"}, []string{"224"}},
+	"GP": {"GP", "GLP", "312", "Guadeloupe", "NA", false, false, "EUR", []string{"fr"}, []string{"590"}},
+	"GQ": {"GQ", "GNQ", "226", "Equatorial Guinea", "AF", false, false, "XAF", []string{"es", "fr", "pt"}, []string{"240"}},
+	"GR": {"GR", "GRC", "300", "Greece", "EU", true, true, "EUR", []string{"el"}, []string{"30"}},
+	"GS": {"GS", "SGS", "239", "South Georgia and the South Sandwich Islands", "OC", false, false, "GBP", nil, []string{"500"}},
+	"GT": {"GT", "GTM", "320", "Guatemala", "NA", false, false, "GTQ", []string{"es"}, []string{"502"}},
+	"GU": {"GU", "GUM", "316", "Guam", "OC", false, false, "USD", []string{"en"}, []string{"1671"}},
+	"GW": {"GW", "GNB", "624", "Guinea-Bissau", "AF", false, false, "XOF", []string{"pt"}, []string{"245"}},
+	"GY": {"GY", "GUY", "328", "Guyana", "SA", false, false, "GYD", []string{"en"}, []string{"592"}},
+	"HK": {"HK", "HKG", "344", "Hong Kong", "AS", false, false, "HKD", []string{"zh", "yue"}, []string{"852"}},
+	"HM": {"HM", "HMD", "334", "Heard Island and McDonald Islands", "OC", false, false, "AUD", nil, []string{"61"}},
+	"HN": {"HN", "HND", "340", "Honduras", "NA", false, false, "HNL", []string{"es"}, []string{"504"}},
+	"HR": {"HR", "HRV", "191", "Croatia", "EU", true, true, "EUR", []string{"hr"}, []string{"385"}},
+	"HT": {"HT", "HTI", "332", "Haiti", "NA", false, false, "HTG", []string{"ht", "fr"}, []string{"509"}},
+	"HU": {"HU", "HUN", "348", "Hungary", "EU", true, true, "HUF", []string{"hu"}, []string{"36"}},
+	"ID": {"ID", "IDN", "360", "Indonesia", "AS", false, false, "IDR", []string{"id", "jv"}, []string{"62"}},
+	"IE": {"IE", "IRL", "372", "Ireland", "EU", true, true, "EUR", []string{"en", "ga"}, []string{"353"}},
+	"IL": {"IL", "ISR", "376", "Israel", "AS", false, false, "ILS", []string{"he", "ar"}, []string{"972"}},
+	"IM": {"IM", "IMN", "833", "Isle of Man", "EU", false, false, "GBP", []string{"en", "gv"}, []string{"44", "441624"}},
+	"IN": {"IN", "IND", "356", "India", "AS", false, false, "INR", []string{"hi", "as", "bn", "bo", "brx", "ccp", "gu", "kn", "kok", "ks", "ml", "mr", "ne", "or", "ta", "te", "ur"}, []string{"91"}},
+	"IO": {"IO", "IOT", "086", "British Indian Ocean Territory", "OC", false, false, "USD", []string{"en"}, []string{"246"}},
+	"IQ": {"IQ", "IRQ", "368", "Iraq", "AS", false, false, "IQD", []string{"ar", "ckb", "lrc"}, []string{"964"}},
+	"IR": {"IR", "IRN", "364", "Iran", "AS", false, false, "IRR", []string{"fa", "ckb", "lrc", "mzn"}, []string{"98"}},
+	"IS": {"IS", "ISL", "352", "Iceland", "EU", false, true, "ISK", []string{"is"}, []string{"354"}},
+	"IT": {"IT", "ITA", "380", "Italy", "EU", true, true, "EUR", []string{"it", "ca", "de", "fur"}, []string{"39"}},
+	"JE": {"JE", "JEY", "832", "Jersey", "EU", false, false, "GBP", []string{"en"}, []string{"44", "441534"}},
+	"JM": {"JM", "JAM", "388", "Jamaica", "NA", false, false, "JMD", []string{"en"}, []string{"1876", "1658"}},
+	"JO": {"JO", "JOR", "400", "Jordan", "AS", false, false, "JOD", []string{"ar"}, []string{"962"}},
+	"JP": {"JP", "JPN", "392", "Japan", "AS", false, false, "JPY", []string{"ja"}, []string{"81"}},
+	"KE": {"KE", "KEN", "404", "Kenya", "AF", false, false, "KES", []string{"sw", "dav", "ebu", "guz", "kam", "ki", "kln", "luo", "luy", "mas", "mer", "om", "saq", "so", "teo"}, []string{"254"}},
+	"KG": {"KG", "KGZ", "417", "Kyrgyzstan", "AS", false, false, "KGS", []string{"ky", "ru"}, []string{"996"}},
+	"KH": {"KH", "KHM", "116", "Cambodia", "AS", false, false, "KHR", []string{"km"}, []string{"855"}},
+	"KI": {"KI", "KIR", "296", "Kiribati", "OC", false, false, "AUD", []string{"en"}, []string{"686"}},
+	"KM": {"KM", "COM", "174", "Comoros", "AF", false, false, "KMF", []string{"ar", "fr"}, []string{"269"}},
+	"KN": {"KN", "KNA", "659", "Saint Kitts and Nevis", "NA", false, false, "XCD", []string{"en"}, []string{"1869"}},
+	"KP": {"KP", "PRK", "408", "North Korea", "AS", false, false, "KPW", []string{"ko"}, []string{"850"}},
+	"KR": {"KR", "KOR", "410", "South Korea", "AS", false, false, "KRW", []string{"ko"}, []string{"82"}},
+	"KW": {"KW", "KWT", "414", "Kuwait", "AS", false, false, "KWD", []string{"ar"}, []string{"965"}},
+	"KY": {"KY", "CYM", "136", "Cayman Islands", "NA", false, false, "KYD", []string{"en"}, []string{"1345"}},
+	"KZ": {"KZ", "KAZ", "398", "Kazakhstan", "AS", false, false, "KZT", []string{"ru", "kk"}, []string{"7"}},
+	"LA": {"LA", "LAO", "418", "Laos", "AS", false, false, "LAK", []string{"lo"}, []string{"856"}},
+	"LB": {"LB", "LBN", "422", "Lebanon", "AS", false, false, "LBP", []string{"ar"}, []string{"961"}},
+	"LC": {"LC", "LCA", "662", "Saint Lucia", "NA", false, false, "XCD", []string{"en"}, []string{"1758"}},
+	"LI": {"LI", "LIE", "438", "Liechtenstein", "EU", false, true, "CHF", []string{"de", "gsw"}, []string{"423"}},
+	"LK": {"LK", "LKA", "144", "Sri Lanka", "AS", false, false, "LKR", []string{"si", "ta"}, []string{"94"}},
+	"LR": {"LR", "LBR", "430", "Liberia", "AF", false, false, "LRD", []string{"en"}, []string{"231"}},
+	"LS": {"LS", "LSO", "426", "Lesotho", "AF", false, false, "ZAR", []string{"st"}, []string{"266"}},
+	"LT": {"LT", "LTU", "440", "Lithuania", "EU", true, true, "EUR", []string{"lt"}, []string{"370"}},
+	"LU": {"LU", "LUX", "442", "Luxembourg", "EU", true, true, "EUR", []string{"fr", "de", "lb", "pt"}, []string{"352"}},
+	"LV": {"LV", "LVA", "428", "Latvia", "EU", true, true, "EUR", []string{"lv"}, []string{"371"}},
+	"LY": {"LY", "LBY", "434", "Libya", "AF", false, false, "LYD", []string{"ar"}, []string{"218"}},
+	"MA": {"MA", "MAR", "504", "Morocco", "AF", false, false, "MAD", []string{"ar", "fr", "tzm", "zgh"}, []string{"212"}},
+	"MC": {"MC", "MCO", "492", "Monaco", "EU", false, false, "EUR", []string{"fr"}, []string{"377"}},
+	"MD": {"MD", "MDA", "498", "Moldova", "EU", false, false, "MDL", []string{"ro", "ru"}, []string{"373"}},
+	"ME": {"ME", "MNE", "499", "Montenegro", "EU", false, false, "EUR", []string{"sr"}, []string{"382"}},
+	"MF": {"MF", "MAF", "663", "Saint Martin (French part)", "NA", false, false, "EUR", []string{"fr"}, []string{"590"}},
+	"MG": {"MG", "MDG", "450", "Madagascar", "AF", false, false, "MGA", []string{"mg", "fr"}, []string{"261"}},
+	"MH": {"MH", "MHL", "584", "Marshall Islands", "OC", false, false, "USD", []string{"en"}, []string{"692"}},
+	"MK": {"MK", "MKD", "807", "North Macedonia", "EU", false, false, "MKD", []string{"mk", "sq"}, []string{"389"}},
+	"ML": {"ML", "MLI", "466", "Mali", "AF", false, false, "XOF", []string{"bm", "fr", "khq", "ses"}, []string{"223"}},
+	"MM": {"MM", "MMR", "104", "Myanmar", "AS", false, false, "MMK", []string{"my"}, []string{"95"}},
+	"MN": {"MN", "MNG", "496", "Mongolia", "AS", false, false, "MNT", []string{"mn"}, []string{"976"}},
+	"MO": {"MO", "MAC", "446", "Macao", "AS", false, false, "MOP", []string{"zh", "pt"}, []string{"853"}},
+	"MP": {"MP", "MNP", "580", "Northern Mariana Islands", "OC", false, false, "USD", []string{"en"}, []string{"1670"}},
+	"MQ": {"MQ", "MTQ", "474", "Martinique", "NA", false, false, "EUR", []string{"fr"}, []string{"596"}},
+	"MR": {"MR", "MRT", "478", "Mauritania", "AF", false, false, "MRU", []string{"ar", "ff", "fr"}, []string{"222"}},
+	"MS": {"MS", "MSR", "500", "Montserrat", "NA", false, false, "XCD", []string{"en"}, []string{"1664"}},
+	"MT": {"MT", "MLT", "470", "Malta", "EU", true, true, "EUR", []string{"mt"}, []string{"356"}},
+	"MU": {"MU", "MUS", "480", "Mauritius", "AF", false, false, "MUR", []string{"mfe", "fr"}, []string{"230"}},
+	"MV": {"MV", "MDV", "462", "Maldives", "AS", false, false, "MVR", []string{"dv"}, []string{"960"}},
+	"MW": {"MW", "MWI", "454", "Malawi", "AF", false, false, "MWK", []string{"en"}, []string{"265"}},
+	"MX": {"MX", "MEX", "484", "Mexico", "NA", false, false, "MXN", []string{"es"}, []string{"52"}},
+	"MY": {"MY", "MYS", "458", "Malaysia", "AS", false, false, "MYR", []string{"ms", "ta"}, []string{"60"}},
+	"MZ": {"MZ", "MOZ", "508", "Mozambique", "AF", false, false, "MZN", []string{"pt", "mgh", "seh"}, []string{"258"}},
+	"NA": {"NA", "NAM", "516", "Namibia", "AF", false, false, "NAD", []string{"af", "naq"}, []string{"264"}},
+	"NC": {"NC", "NCL", "540", "New Caledonia", "OC", false, false, "XPF", []string{"fr"}, []string{"687"}},
+	"NE": {"NE", "NER", "562", "Niger", "AF", false, false, "XOF", []string{"ha", "dje", "fr", "twq"}, []string{"227"}},
+	"NF": {"NF", "NFK", "574", "Norfolk Island", "OC", false, false, "AUD", []string{"en"}, []string{"672"}},
+	"NG": {"NG", "NGA", "566", "Nigeria", "AF", false, false, "NGN", []string{"en", "ha", "ig", "yo"}, []string{"234"}},
+	"NI": {"NI", "NIC", "558", "Nicaragua", "NA", false, false, "NIO", []string{"es"}, []string{"505"}},
+	"NL": {"NL", "NLD", "528", "Netherlands", "EU", true, true, "EUR", []string{"nl", "fy", "nds"}, []string{"31"}},
+	"NO": {"NO", "NOR", "578", "Norway", "EU", false, true, "NOK", []string{"nb", "nn", "se"}, []string{"47"}},
+	"NP": {"NP", "NPL", "524", "Nepal", "AS", false, false, "NPR", []string{"ne"}, []string{"977"}},
+	"NR": {"NR", "NRU", "520", "Nauru", "OC", false, false, "AUD", []string{"en"}, []string{"674"}},
+	"NU": {"NU", "NIU", "570", "Niue", "OC", false, false, "NZD", []string{"en"}, []string{"683"}},
+	"NZ": {"NZ", "NZL", "554", "New Zealand", "OC", false, false, "NZD", []string{"en", "mi"}, []string{"64"}},
+	"OM": {"OM", "OMN", "512", "Oman", "AS", false, false, "OMR", []string{"ar"}, []string{"968"}},
+	"PA": {"PA", "PAN", "591", "Panama", "NA", false, false, "PAB", []string{"es"}, []string{"507"}},
+	"PE": {"PE", "PER", "604", "Peru", "SA", false, false, "PEN", []string{"es", "qu"}, []string{"51"}},
+	"PF": {"PF", "PYF", "258", "French Polynesia", "OC", false, false, "XPF", []string{"fr"}, []string{"689"}},
+	"PG": {"PG", "PNG", "598", "Papua New Guinea", "OC", false, false, "PGK", []string{"tpi"}, []string{"675"}},
+	"PH": {"PH", "PHL", "608", "Philippines", "AS", false, false, "PHP", []string{"fil", "ceb", "es"}, []string{"63"}},
+	"PK": {"PK", "PAK", "586", "Pakistan", "AS", false, false, "PKR", []string{"ur", "ps", "sd"}, []string{"92"}},
+	"PL": {"PL", "POL", "616", "Poland", "EU", true, true, "PLN", []string{"pl"}, []string{"48"}},
+	"PM": {"PM", "SPM", "666", "Saint Pierre and Miquelon", "NA", false, false, "EUR", []string{"fr"}, []string{"508"}},
+	"PN": {"PN", "PCN", "612", "Pitcairn", "OC", false, false, "NZD", []string{"en"}, []string{"64"}},
+	"PR": {"PR", "PRI", "630", "Puerto Rico", "NA", false, false, "USD", []string{"es"}, []string{"1787", "1939"}},
+	"PS": {"PS", "PSE", "275", "Palestine, State of", "AS", false, false, "ILS", []string{"ar"}, []string{"970"}},
+	"PT": {"PT", "PRT", "620", "Portugal", "EU", true, true, "EUR", []string{"pt"}, []string{"351"}},
+	"PW": {"PW", "PLW", "585", "Palau", "OC", false, false, "USD", []string{"pau"}, []string{"680"}},
+	"PY": {"PY", "PRY", "600", "Paraguay", "SA", false, false, "PYG", []string{"gn", "es"}, []string{"595"}},
+	"QA": {"QA", "QAT", "634", "Qatar", "AS", false, false, "QAR", []string{"ar"}, []string{"974"}},
+	"RE": {"RE", "REU", "638", "Réunion", "AF", false, false, "EUR", []string{"fr"}, []string{"262"}},
+	"RO": {"RO", "ROU", "642", "Romania", "EU", true, true, "RON", []string{"ro"}, []string{"40"}},
+	"RS": {"RS", "SRB", "688", "Serbia", "EU", false, false, "RSD", []string{"sr"}, []string{"381"}},
+	"RU": {"RU", "RUS", "643", "Russian Federation", "EU", false, false, "RUB", []string{"ru", "ce", "cu", "os", "sah", "tt"}, []string{"7"}},
+	"RW": {"RW", "RWA", "646", "Rwanda", "AF", false, false, "RWF", []string{"rw", "fr"}, []string{"250"}},
+	"SA": {"SA", "SAU", "682", "Saudi Arabia", "AS", false, false, "SAR", []string{"ar"}, []string{"966"}},
+	"SB": {"SB", "SLB", "090", "Solomon Islands", "OC", false, false, "SBD", []string{"en"}, []string{"677"}},
+	"SC": {"SC", "SYC", "690", "Seychelles", "AF", false, false, "SCR", []string{"fr"}, []string{"248"}},
+	"SD": {"SD", "SDN", "729", "Sudan", "AF", false, false, "SDG", []string{"ar"}, []string{"249"}},
+	"SE": {"SE", "SWE", "752", "Sweden", "EU", true, true, "SEK", []string{"sv", "se"}, []string{"46"}},
+	"SG": {"SG", "SGP", "702", "Singapore", "AS", false, false, "SGD", []string{"en", "ms", "ta"}, []string{"65"}},
+	"SH": {"SH", "SHN", "654", "Saint Helena, Ascension and Tristan da Cunha", "AF", false, false, "SHP", []string{"en"}, []string{"290"}},
+	"SI": {"SI", "SVN", "705", "Slovenia", "EU", true, true, "EUR", []string{"sl"}, []string{"386"}},
+	"SJ": {"SJ", "SJM", "744", "Svalbard and Jan Mayen", "EU", false, false, "NOK", []string{"nb"}, []string{"4779"}},
+	"SK": {"SK", "SVK", "703", "Slovakia", "EU", true, true, "EUR", []string{"sk"}, []string{"421"}},
+	"SL": {"SL", "SLE", "694", "Sierra Leone", "AF", false, false, "SLE", []string{"en"}, []string{"232"}},
+	"SM": {"SM", "SMR", "674", "San Marino", "EU", false, false, "EUR", []string{"it"}, []string{"378"}},
+	"SN": {"SN", "SEN", "686", "Senegal", "AF", false, false, "XOF", []string{"fr", "dyo", "ff", "wo"}, []string{"221"}},
+	"SO": {"SO", "SOM", "706", "Somalia", "AF", false, false, "SOS", []string{"so", "ar"}, []string{"252"}},
+	"SR": {"SR", "SUR", "740", "Suriname", "SA", false, false, "SRD", []string{"nl"}, []string{"597"}},
+	"SS": {"SS", "SSD", "728", "South Sudan", "AF", false, false, "SSP", []string{"en", "ar", "nus"}, []string{"211"}},
+	"ST": {"ST", "STP", "678", "Sao Tome and Principe", "AF", false, false, "STN", []string{"pt"}, []string{"239"}},
+	"SV": {"SV", "SLV", "222", "El Salvador", "NA", false, false, "USD", []string{"es"}, []string{"503"}},
+	"SX": {"SX", "SXM", "534", "Sint Maarten (Dutch part)", "NA", false, false, "XCG", []string{"en", "nl"}, []string{"1721"}},
+	"SY": {"SY", "SYR", "760", "Syria", "AS", false, false, "SYP", []string{"ar", "fr"}, []string{"963"}},
+	"SZ": {"SZ", "SWZ", "748", "Eswatini", "AF", false, false, "SZL", []string{"en"}, []string{"268"}},
+	"TC": {"TC", "TCA", "796", "Turks and Caicos Islands", "NA", false, false, "USD", []string{"en"}, []string{"1649"}},
+	"TD": {"TD", "TCD", "148", "Chad", "AF", false, false, "XAF", []string{"fr", "ar"}, []string{"235"}},
+	"TF": {"TF", "ATF", "260", "French Southern Territories", "OC", false, false, "EUR", []string{"fr"}, []string{"1"}},
+	"TG": {"TG", "TGO", "768", "Togo", "AF", false, false, "XOF", []string{"fr", "ee"}, []string{"228"}},
+	"TH": {"TH", "THA", "764", "Thailand", "AS", false, false, "THB", []string{"th"}, []string{"66"}},
+	"TJ": {"TJ", "TJK", "762", "Tajikistan", "AS", false, false, "TJS", []string{"tg"}, []string{"992"}},
+	"TK": {"TK", "TKL", "772", "Tokelau", "OC", false, false, "NZD", []string{"tkl"}, []string{"690"}},
+	"TL": {"TL", "TLS", "626", "Timor-Leste", "AS", false, false, "USD", []string{"pt"}, []string{"670"}},
+	"TM": {"TM", "TKM", "795", "Turkmenistan", "AS", false, false, "TMT", []string{"tk"}, []string{"993"}},
+	"TN": {"TN", "TUN", "788", "Tunisia", "AF", false, false, "TND", []string{"ar", "fr"}, []string{"216"}},
+	"TO": {"TO", "TON", "776", "Tonga", "OC", false, false, "TOP", []string{"to"}, []string{"676"}},
+	"TR": {"TR", "TUR", "792", "Türkiye", "AS", false, false, "TRY", []string{"tr", "ku"}, []string{"90"}},
+	"TT": {"TT", "TTO", "780", "Trinidad and Tobago", "NA", false, false, "TTD", []string{"en"}, []string{"1868"}},
+	"TV": {"TV", "TUV", "798", "Tuvalu", "OC", false, false, "AUD", []string{"tvl"}, []string{"688"}},
+	"TW": {"TW", "TWN", "158", "Taiwan", "AS", false, false, "TWD", []string{"zh"}, []string{"886"}},
+	"TZ": {"TZ", "TZA", "834", "Tanzania", "AF", false, false, "TZS", []string{"sw", "asa", "bez", "jmc", "kde", "ksb", "lag", "mas", "rof", "rwk", "sbp", "vun"}, []string{"255"}},
+	"UA": {"UA", "UKR", "804", "Ukraine", "EU", false, false, "UAH", []string{"uk", "ru"}, []string{"380"}},
+	"UG": {"UG", "UGA", "800", "Uganda", "AF", false, false, "UGX", []string{"sw", "cgg", "lg", "nyn", "teo", "xog"}, []string{"256"}},
+	"UM": {"UM", "UMI", "581", "United States Minor Outlying Islands", "OC", false, false, "USD", []string{"en"}, []string{"1"}},
+	"US": {"US", "USA", "840", "United States", "NA", false, false, "USD", []string{"en", "chr", "es", "haw", "lkt"}, []string{"1"}},
+	"UY": {"UY", "URY", "858", "Uruguay", "SA", false, false, "UYU", []string{"es"}, []string{"598"}},
+	"UZ": {"UZ", "UZB", "860", "Uzbekistan", "AS", false, false, "UZS", []string{"uz"}, []string{"998"}},
+	"VA": {"VA", "VAT", "336", "Holy See (Vatican City State)", "EU", false, false, "EUR", []string{"it"}, []string{"39", "379"}},
+	"VC": {"VC", "VCT", "670", "Saint Vincent and the Grenadines", "NA", false, false, "XCD", []string{"en"}, []string{"1784"}},
+	"VE": {"VE", "VEN", "862", "Venezuela", "SA", false, false, "VES", []string{"es"}, []string{"58"}},
+	"VG": {"VG", "VGB", "092", "Virgin Islands, British", "NA", false, false, "USD", []string{"en"}, []string{"1284"}},
+	"VI": {"VI", "VIR", "850", "Virgin Islands, U.S.", "NA", false, false, "USD", []string{"en"}, []string{"1340"}},
+	"VN": {"VN", "VNM", "704", "Vietnam", "AS", false, false, "VND", []string{"vi"}, []string{"84"}},
+	"VU": {"VU", "VUT", "548", "Vanuatu", "OC", false, false, "VUV", []string{"bi", "fr"}, []string{"678"}},
+	"WF": {"WF", "WLF", "876", "Wallis and Futuna", "OC", false, false, "XPF", []string{"fr"}, []string{"681"}},
+	"WS": {"WS", "WSM", "882", "Samoa", "OC", false, false, "WST", []string{"sm"}, []string{"685"}},
+	"XK": {"XK", "XKX", "", "Kosovo", "EU", false, false, "EUR", []string{"sq", "sr"}, []string{"383"}},
+	"YE": {"YE", "YEM", "887", "Yemen", "AS", false, false, "YER", []string{"ar"}, []string{"967"}},
+	"YT": {"YT", "MYT", "175", "Mayotte", "AF", false, false, "EUR", []string{"fr"}, []string{"262", "262269", "262639"}},
+	"ZA": {"ZA", "ZAF", "710", "South Africa", "AF", false, false, "ZAR", []string{"en", "af", "xh", "zu"}, []string{"27"}},
+	"ZM": {"ZM", "ZMB", "894", "Zambia", "AF", false, false, "ZMW", []string{"en", "bem"}, []string{"260"}},
+	"ZW": {"ZW", "ZWE", "716", "Zimbabwe", "AF", false, false, "USD", []string{"sn", "nd"}, []string{"263"}},
+}
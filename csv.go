@@ -0,0 +1,98 @@
+package ip2location
+
+import (
+	"bufio"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// CSVFormat selects how addresses are written by WriteCSV
+type CSVFormat int
+
+const (
+	CSVNumber  CSVFormat = iota // "ip_from","ip_to" as IP numbers, as in IP2Location CSV files
+	CSVAddress                  // "ip_from","ip_to" as dotted or colon-hexadecimal addresses
+	CSVCIDR                     // "cidr", one line for each CIDR block of a range
+)
+
+// CSVOptions configures WriteCSV
+type CSVOptions struct {
+	Mode   uint32 // fields to write; 0 writes every field of the database
+	Format CSVFormat
+	Header bool // write column names on the first line
+}
+
+// WriteCSV writes the IPv4 (family 4) or IPv6 (family 6) table in IP2Location CSV layout.
+// As in IP2Location CSV files, every value is quoted and "ip_to" is inclusive.
+// Rows are streamed from the database, so memory use does not depend on its size.
+func (db *DB) WriteCSV(w io.Writer, family int, opts *CSVOptions) error {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+	mode := dbmode(db.meta.databasetype)
+	if opts.Mode != 0 {
+		mode &= opts.Mode
+	}
+
+	bw := bufio.NewWriter(w)
+	line := make([]string, 0, len(fields)+2)
+	if opts.Header {
+		if opts.Format == CSVCIDR {
+			line = append(line, "cidr")
+		} else {
+			line = append(line, "ip_from", "ip_to")
+		}
+		for _, f := range fields {
+			if mode&f.mode != 0 {
+				line = append(line, f.name)
+			}
+		}
+		writecsvline(bw, line)
+	}
+
+	last := new(big.Int)
+	values := make([]string, 0, len(fields))
+	it := db.Ranges(family, mode)
+	for it.Next() {
+		r := it.Range()
+		values = values[:0]
+		for _, f := range fields {
+			if mode&f.mode != 0 {
+				values = append(values, f.get(r.Record))
+			}
+		}
+		switch opts.Format {
+		case CSVCIDR:
+			for _, p := range rangeprefixes(family, r.From, r.To) {
+				line = append(append(line[:0], p.String()), values...)
+				writecsvline(bw, line)
+			}
+			continue
+		case CSVAddress:
+			last.Sub(r.To, big.NewInt(1))
+			line = append(line[:0], ipnumaddr(family, r.From).String(), ipnumaddr(family, last).String())
+		default:
+			last.Sub(r.To, big.NewInt(1))
+			line = append(line[:0], r.From.String(), last.String())
+		}
+		writecsvline(bw, append(line, values...))
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// write a line of quoted values; errors are reported by Flush
+func writecsvline(w *bufio.Writer, values []string) {
+	for i, v := range values {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteByte('"')
+		w.WriteString(strings.Replace(v, `"`, `""`, -1))
+		w.WriteByte('"')
+	}
+	w.WriteString("\r\n")
+}
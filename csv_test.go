@@ -0,0 +1,178 @@
+package ip2location_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestWriteCSV(t *testing.T) {
+	db := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+		"1.0.0.0/24":    tokyo,
+		"1.0.2.0/23":    paris,
+		"2001:db8::/32": paris,
+	})
+	defer db.Close()
+	for _, tt := range []struct {
+		name   string
+		family int
+		opts   *ip2location.CSVOptions
+		want   string // the first lines
+	}{
+		{"number", 4, &ip2location.CSVOptions{Header: true}, "" +
+			`"ip_from","ip_to","country_code","country_name","region_name","city_name"` + "\r\n" +
+			`"0","16777215","","","",""` + "\r\n" +
+			`"16777216","16777471","JP","Japan","Tokyo","Tokyo"` + "\r\n" +
+			`"16777472","16777727","","","",""` + "\r\n" +
+			`"16777728","16778239","FR","France","Ile-de-France","Paris"` + "\r\n" +
+			`"16778240","4294967295","","","",""` + "\r\n"},
+		{"nil options", 4, nil, "" +
+			`"0","16777215","","","",""` + "\r\n" +
+			`"16777216","16777471","JP","Japan","Tokyo","Tokyo"` + "\r\n"},
+		{"address", 4, &ip2location.CSVOptions{Format: ip2location.CSVAddress, Mode: ip2location.ModeCountryShort | ip2location.ModeCity}, "" +
+			`"0.0.0.0","0.255.255.255","",""` + "\r\n" +
+			`"1.0.0.0","1.0.0.255","JP","Tokyo"` + "\r\n" +
+			`"1.0.1.0","1.0.1.255","",""` + "\r\n" +
+			`"1.0.2.0","1.0.3.255","FR","Paris"` + "\r\n" +
+			`"1.0.4.0","255.255.255.255","",""` + "\r\n"},
+		{"cidr", 4, &ip2location.CSVOptions{Format: ip2location.CSVCIDR, Header: true, Mode: ip2location.ModeCountryShort}, "" +
+			`"cidr","country_code"` + "\r\n" +
+			`"0.0.0.0/8",""` + "\r\n" +
+			`"1.0.0.0/24","JP"` + "\r\n" +
+			`"1.0.1.0/24",""` + "\r\n" +
+			`"1.0.2.0/23","FR"` + "\r\n" +
+			`"1.0.4.0/22",""` + "\r\n"},
+		{"ipv6", 6, &ip2location.CSVOptions{Format: ip2location.CSVAddress, Mode: ip2location.ModeCountryShort}, "" +
+			`"::","2001:db7:ffff:ffff:ffff:ffff:ffff:ffff",""` + "\r\n" +
+			`"2001:db8::","2001:db8:ffff:ffff:ffff:ffff:ffff:ffff","FR"` + "\r\n" +
+			`"2001:db9::","ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",""` + "\r\n"},
+	} {
+		var buf bytes.Buffer
+		if err := db.WriteCSV(&buf, tt.family, tt.opts); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !strings.HasPrefix(buf.String(), tt.want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, buf.String(), tt.want)
+		}
+	}
+}
+
+func TestWriteCSVQuoting(t *testing.T) {
+	db := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+		"1.0.0.0/24": {CountryShort: "US", CountryLong: "United States", Region: "New York", City: `The "Big" Apple, NY`},
+	})
+	defer db.Close()
+	var buf bytes.Buffer
+	if err := db.WriteCSV(&buf, 4, &ip2location.CSVOptions{Format: ip2location.CSVCIDR}); err != nil {
+		t.Fatal(err)
+	}
+	want := `"1.0.0.0/24","US","United States","New York","The ""Big"" Apple, NY"` + "\r\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("got\n%s\nwant a line\n%s", buf.String(), want)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	for _, dbt := range []uint8{1, 5, 26} {
+		src := ip2locationtest.MustNewDB(dbt, table)
+		for _, format := range []ip2location.CSVFormat{ip2location.CSVNumber, ip2location.CSVAddress, ip2location.CSVCIDR} {
+			for _, header := range []bool{false, true} {
+				opts := &ip2location.CSVOptions{Format: format, Header: header}
+				var csv [2]bytes.Buffer
+				w, err := ip2location.NewWriter(dbt, ip2locationtest.Date)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i, family := range []int{4, 6} {
+					if err = src.WriteCSV(&csv[i], family, opts); err != nil {
+						t.Fatal(err)
+					}
+					if err = w.ReadCSV(bytes.NewReader(csv[i].Bytes()), family); err != nil {
+						t.Fatalf("DB%d format %d header %v family %d: %v", dbt, format, header, family, err)
+					}
+				}
+				var bin bytes.Buffer
+				if _, err = w.WriteTo(&bin); err != nil {
+					t.Fatal(err)
+				}
+				db, err := ip2location.NewDBFromReader(bytes.NewReader(bin.Bytes()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i, family := range []int{4, 6} {
+					var again bytes.Buffer
+					if err = db.WriteCSV(&again, family, opts); err != nil {
+						t.Fatal(err)
+					}
+					if again.String() != csv[i].String() {
+						t.Errorf("DB%d format %d header %v family %d: got\n%s\nwant\n%s", dbt, format, header, family, again.String(), csv[i].String())
+					}
+				}
+				for _, ip := range []string{"0.0.0.0", "1.0.0.1", "255.255.255.255", "2001:db8::1", "ffff::1", "::1"} {
+					want, _ := src.GetAll(ip)
+					if got, _ := db.GetAll(ip); *got != *want {
+						t.Errorf("DB%d format %d header %v %s: got %+v, want %+v", dbt, format, header, ip, *got, *want)
+					}
+				}
+			}
+		}
+		src.Close()
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	w, err := ip2location.NewWriter(1, ip2locationtest.Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v4 := "\"ip_from\",\"ip_to\",\"country_code\",\"country_name\"\n" +
+		"\"16777216\",\"16777471\",\"JP\",\"Japan\"\n" +
+		"\"1.0.2.0\",\"1.0.3.255\",\"FR\",\"France\"\n"
+	v6 := "\"cidr\",\"country_name\",\"country_code\"\n" +
+		"\"2001:db8::/32\",\"Germany\",\"DE\"\n" +
+		"\"8.8.8.0/24\",\"United States\",\"US\"\n" // stored as ::ffff:8.8.8.0/120
+	if err = w.ReadCSV(strings.NewReader(v4), 4); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.ReadCSV(strings.NewReader(v6), 6); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.ReadCSV(strings.NewReader(`"2001:db8::/32","DE","Germany"`), 4); err == nil {
+		t.Error("IPv6 prefix in the IPv4 table: no error")
+	}
+	if err = w.ReadCSV(strings.NewReader(`"1","2","DE"`), 4); err == nil {
+		t.Error("missing column: no error")
+	}
+	var bin bytes.Buffer
+	if _, err = w.WriteTo(&bin); err != nil {
+		t.Fatal(err)
+	}
+	db, err := ip2location.NewDBFromReader(bytes.NewReader(bin.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct{ ip, want string }{
+		{"1.0.0.255", "JP"},
+		{"1.0.1.0", ""},
+		{"1.0.2.1", "FR"},
+		{"2001:db8:1::", "DE"},
+	} {
+		r, err := db.GetCountryShort(tt.ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.CountryShort != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.ip, r.CountryShort, tt.want)
+		}
+	}
+	var v6out bytes.Buffer
+	if err = db.WriteCSV(&v6out, 6, &ip2location.CSVOptions{Format: ip2location.CSVCIDR, Mode: ip2location.ModeCountryShort}); err != nil {
+		t.Fatal(err)
+	}
+	if want := `"::ffff:8.8.8.0/120","US"`; !strings.Contains(v6out.String(), want) {
+		t.Errorf("IPv6 table:\n%s\nwant a line %s", v6out.String(), want)
+	}
+}
@@ -0,0 +1,150 @@
+package ip2location
+
+import (
+	"math/big"
+)
+
+// Change is a range whose selected fields differ between two databases
+type Change struct {
+	Family int
+	From   *big.Int // first address
+	To     *big.Int // end of the range, exclusive
+	Old    *Record  // fields selected by the mode of Diff, and the country code
+	New    *Record
+	Fields []FieldChange // the fields that differ, in IP2Location CSV order
+}
+
+// FieldChange is a field of a Change, named as in IP2Location CSV files
+type FieldChange struct {
+	Name string
+	Old  string
+	New  string
+}
+
+// CountryChanges counts the changes of a country
+type CountryChanges struct {
+	Ranges    int
+	Addresses *big.Int
+}
+
+// Diff walks the tables of older and newer in step, and returns the ranges whose fields selected by
+// mode differ, in ascending order, IPv4 first. Adjacent ranges with the same changes are merged.
+// A table missing from one database compares as if every field were empty.
+func Diff(older, newer *DB, mode uint32) ([]Change, error) {
+	var changes []Change
+	for _, family := range []int{4, 6} {
+		if family == 6 && older.meta.ipv6databasecount == 0 && newer.meta.ipv6databasecount == 0 {
+			break
+		}
+		_, end := familyindex(family)
+		a := &diffcursor{it: older.Ranges(family, mode|ModeCountryShort), end: end}
+		b := &diffcursor{it: newer.Ranges(family, mode|ModeCountryShort), end: end}
+		for pos := big.NewInt(0); pos.Cmp(end) < 0; {
+			ra, err := a.at(pos)
+			if err != nil {
+				return nil, err
+			}
+			rb, err := b.at(pos)
+			if err != nil {
+				return nil, err
+			}
+			to := ra.To
+			if rb.To.Cmp(to) < 0 {
+				to = rb.To
+			}
+			if !samerecord(ra.Record, rb.Record, mode) {
+				changes = appendchange(changes, Change{
+					Family: family,
+					From:   pos,
+					To:     to,
+					Old:    ra.Record,
+					New:    rb.Record,
+					Fields: fieldchanges(ra.Record, rb.Record, mode),
+				})
+			}
+			pos = to
+		}
+	}
+	return changes, nil
+}
+
+// SummarizeChanges counts changes by country code; a change of country counts for both the old and the new one
+func SummarizeChanges(changes []Change) map[string]*CountryChanges {
+	summary := make(map[string]*CountryChanges)
+	size := new(big.Int)
+	for _, c := range changes {
+		size.Sub(c.To, c.From)
+		for i, cc := range []string{c.Old.CountryShort, c.New.CountryShort} {
+			if i == 1 && cc == c.Old.CountryShort {
+				break
+			}
+			s := summary[cc]
+			if s == nil {
+				s = &CountryChanges{Addresses: new(big.Int)}
+				summary[cc] = s
+			}
+			s.Ranges++
+			s.Addresses.Add(s.Addresses, size)
+		}
+	}
+	return summary
+}
+
+// rows of a table, looked up in ascending order
+type diffcursor struct {
+	it  *RangeIterator
+	end *big.Int
+	cur Range
+}
+
+// the row containing pos; past the last row, an empty record up to end
+func (c *diffcursor) at(pos *big.Int) (Range, error) {
+	for c.cur.To == nil || c.cur.To.Cmp(pos) <= 0 {
+		if c.it.Next() {
+			c.cur = c.it.Range()
+			continue
+		}
+		if err := c.it.Err(); err != nil {
+			return Range{}, err
+		}
+		c.cur = Range{Family: c.it.family, From: pos, To: c.end, Record: &Record{}}
+	}
+	return c.cur, nil
+}
+
+func appendchange(changes []Change, c Change) []Change {
+	if n := len(changes); n > 0 {
+		last := &changes[n-1]
+		if last.Family == c.Family && last.To.Cmp(c.From) == 0 && samefieldchanges(last.Fields, c.Fields) &&
+			last.Old.CountryShort == c.Old.CountryShort && last.New.CountryShort == c.New.CountryShort {
+			last.To = c.To
+			return changes
+		}
+	}
+	return append(changes, c)
+}
+
+func fieldchanges(a, b *Record, mode uint32) []FieldChange {
+	var changes []FieldChange
+	for _, f := range fields {
+		if mode&f.mode == 0 {
+			continue
+		}
+		if va, vb := f.get(a), f.get(b); va != vb {
+			changes = append(changes, FieldChange{Name: f.name, Old: va, New: vb})
+		}
+	}
+	return changes
+}
+
+func samefieldchanges(a, b []FieldChange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
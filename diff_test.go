@@ -0,0 +1,71 @@
+package ip2location_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestDiff(t *testing.T) {
+	de := ip2location.Record{CountryShort: "DE", CountryLong: "Germany", Region: "Berlin", City: "Berlin"}
+	fr := ip2location.Record{CountryShort: "FR", CountryLong: "France", Region: "Ile-de-France", City: "Paris"}
+	berlin := ip2location.Record{CountryShort: "DE", CountryLong: "Germany", Region: "Berlin", City: "Berlin-Mitte"}
+	older := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+		"1.0.0.0/24":    de,
+		"2.0.0.0/23":    de,
+		"3.0.0.0/24":    de,
+		"2001:db8::/32": de,
+	})
+	defer older.Close()
+	newer := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+		"1.0.0.0/24":    de,
+		"2.0.0.0/23":    fr,
+		"3.0.0.0/24":    berlin,
+		"2001:db8::/33": de,
+	})
+	defer newer.Close()
+
+	for _, tt := range []struct {
+		name string
+		mode uint32
+		want []string
+	}{
+		{"country", ip2location.ModeCountryShort, []string{
+			"2.0.0.0/23 country_code DE FR",
+			"2001:db8:8000::/33 country_code DE ",
+		}},
+		{"city", ip2location.ModeCountryShort | ip2location.ModeCity, []string{
+			"2.0.0.0/23 country_code DE FR, city_name Berlin Paris",
+			"3.0.0.0/24 city_name Berlin Berlin-Mitte",
+			"2001:db8:8000::/33 country_code DE , city_name Berlin ",
+		}},
+	} {
+		changes, err := ip2location.Diff(older, newer, tt.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, c := range changes {
+			prefixes, err := ip2location.RangePrefixes(c.Family, c.From, c.To)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b bytes.Buffer
+			fmt.Fprint(&b, prefixes[0])
+			for i, f := range c.Fields {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				fmt.Fprintf(&b, " %s %s %s", f.Name, f.Old, f.New)
+			}
+			got = append(got, b.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
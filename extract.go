@@ -0,0 +1,80 @@
+package ip2location
+
+import (
+	"io"
+	"strings"
+)
+
+// ExtractPolicy selects what Extract keeps of ranges outside of the chosen countries and regions
+type ExtractPolicy int
+
+const (
+	ExtractPlaceholder ExtractPolicy = iota // every text field set to "-" and numbers to 0
+	ExtractCountryOnly                      // country code and name only
+)
+
+// ExtractOptions selects the ranges kept in full by Extract
+type ExtractOptions struct {
+	Countries []string            // country codes, as in Record.CountryShort
+	Regions   map[string][]string // region names by country code, as in Record.Region
+	Other     ExtractPolicy
+}
+
+// Extract writes a copy of src of the same database type, keeping every field of ranges in the
+// chosen countries or regions, and collapsing all other ranges as set by opts.Other.
+// Adjacent collapsed ranges are merged. A nil opts collapses every range.
+func Extract(w io.Writer, src *DB, opts *ExtractOptions) (int64, error) {
+	if opts == nil {
+		opts = &ExtractOptions{}
+	}
+	keep := make(map[string]bool)
+	for _, cc := range opts.Countries {
+		keep[strings.ToUpper(cc)] = true
+	}
+	regions := make(map[string]bool)
+	for cc, names := range opts.Regions {
+		for _, name := range names {
+			regions[strings.ToUpper(cc)+"\x00"+strings.ToLower(name)] = true
+		}
+	}
+	mode := dbmode(src.meta.databasetype)
+	dst, err := NewWriter(src.meta.databasetype, src.Metadata().Date)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, family := range []int{4, 6} {
+		if family == 6 && src.meta.ipv6databasecount == 0 {
+			break
+		}
+		it := src.Ranges(family, mode)
+		for it.Next() {
+			r := it.Range()
+			rec := r.Record
+			if !keep[rec.CountryShort] && !regions[rec.CountryShort+"\x00"+strings.ToLower(rec.Region)] {
+				rec = collapse(rec, mode, opts.Other)
+			}
+			if err = dst.Add(family, r.From, r.To, rec); err != nil {
+				return 0, err
+			}
+		}
+		if err = it.Err(); err != nil {
+			return 0, err
+		}
+	}
+	return dst.WriteTo(w)
+}
+
+// reduce rec as set by policy
+func collapse(rec *Record, mode uint32, policy ExtractPolicy) *Record {
+	x := &Record{CountryShort: rec.CountryShort, CountryLong: rec.CountryLong}
+	if policy == ExtractCountryOnly {
+		return x
+	}
+	for _, f := range fields {
+		if mode&f.mode != 0 {
+			f.set(x, "-")
+		}
+	}
+	return x
+}
@@ -0,0 +1,213 @@
+package ip2location
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// field describes a column of the database, in IP2Location CSV order
+type field struct {
+	mode     uint32
+	name     string  // column name in IP2Location CSV files
+	position []uint8 // column position by database type
+	get      func(*Record) string
+	set      func(*Record, string) error
+}
+
+var fields = []field{
+	{ModeCountryShort, "country_code", countryPosition[:],
+		func(r *Record) string { return r.CountryShort },
+		func(r *Record, s string) error { r.CountryShort = s; return nil }},
+	{ModeCountryLong, "country_name", countryPosition[:],
+		func(r *Record) string { return r.CountryLong },
+		func(r *Record, s string) error { r.CountryLong = s; return nil }},
+	{ModeRegion, "region_name", regionPosition[:],
+		func(r *Record) string { return r.Region },
+		func(r *Record, s string) error { r.Region = s; return nil }},
+	{ModeCity, "city_name", cityPosition[:],
+		func(r *Record) string { return r.City },
+		func(r *Record, s string) error { r.City = s; return nil }},
+	{ModeLatitude, "latitude", latitudePosition[:],
+		func(r *Record) string { return formatcoord(r.Latitude) },
+		func(r *Record, s string) error { return parsefloat(&r.Latitude, s) }},
+	{ModeLongitude, "longitude", longitudePosition[:],
+		func(r *Record) string { return formatcoord(r.Longitude) },
+		func(r *Record, s string) error { return parsefloat(&r.Longitude, s) }},
+	{ModeZipCode, "zip_code", zipcodePosition[:],
+		func(r *Record) string { return r.ZipCode },
+		func(r *Record, s string) error { r.ZipCode = s; return nil }},
+	{ModeTimeZone, "time_zone", timezonePosition[:],
+		func(r *Record) string { return r.TimeZone },
+		func(r *Record, s string) error { r.TimeZone = s; return nil }},
+	{ModeISP, "isp", ispPosition[:],
+		func(r *Record) string { return r.ISP },
+		func(r *Record, s string) error { r.ISP = s; return nil }},
+	{ModeDomain, "domain", domainPosition[:],
+		func(r *Record) string { return r.Domain },
+		func(r *Record, s string) error { r.Domain = s; return nil }},
+	{ModeNetSpeed, "net_speed", netspeedPosition[:],
+		func(r *Record) string { return r.NetSpeed },
+		func(r *Record, s string) error { r.NetSpeed = s; return nil }},
+	{ModeIddCode, "idd_code", iddcodePosition[:],
+		func(r *Record) string { return r.IddCode },
+		func(r *Record, s string) error { r.IddCode = s; return nil }},
+	{ModeAreaCode, "area_code", areacodePosition[:],
+		func(r *Record) string { return r.AreaCode },
+		func(r *Record, s string) error { r.AreaCode = s; return nil }},
+	{ModeWeatherStationCode, "weather_station_code", weatherstationcodePosition[:],
+		func(r *Record) string { return r.WeatherStationCode },
+		func(r *Record, s string) error { r.WeatherStationCode = s; return nil }},
+	{ModeWeatherStationName, "weather_station_name", weatherstationnamePosition[:],
+		func(r *Record) string { return r.WeatherStationName },
+		func(r *Record, s string) error { r.WeatherStationName = s; return nil }},
+	{ModeMobileCountryCode, "mcc", mccPosition[:],
+		func(r *Record) string { return r.MobileCountryCode },
+		func(r *Record, s string) error { r.MobileCountryCode = s; return nil }},
+	{ModeMobileNetworkCode, "mnc", mncPosition[:],
+		func(r *Record) string { return r.MobileNetworkCode },
+		func(r *Record, s string) error { r.MobileNetworkCode = s; return nil }},
+	{ModeMobileBrand, "mobile_brand", mobilebrandPosition[:],
+		func(r *Record) string { return r.MobileBrand },
+		func(r *Record, s string) error { r.MobileBrand = s; return nil }},
+	{ModeElevation, "elevation", elevationPosition[:],
+		func(r *Record) string { return strconv.FormatFloat(float64(r.Elevation), 'f', -1, 32) },
+		func(r *Record, s string) error { return parsefloat(&r.Elevation, s) }},
+	{ModeUsageType, "usage_type", usagetypePosition[:],
+		func(r *Record) string { return r.UsageType },
+		func(r *Record, s string) error { r.UsageType = s; return nil }},
+	{ModeAddressType, "address_type", addresstypePosition[:],
+		func(r *Record) string { return r.AddressType },
+		func(r *Record, s string) error { r.AddressType = s; return nil }},
+	{ModeCategory, "category", categoryPosition[:],
+		func(r *Record) string { return r.Category },
+		func(r *Record, s string) error { r.Category = s; return nil }},
+	{ModeDistrict, "district", districtPosition[:],
+		func(r *Record) string { return r.District },
+		func(r *Record, s string) error { r.District = s; return nil }},
+	{ModeASN, "asn", asnPosition[:],
+		func(r *Record) string { return r.ASN },
+		func(r *Record, s string) error { r.ASN = s; return nil }},
+	{ModeAS, "as", asPosition[:],
+		func(r *Record) string { return r.AS },
+		func(r *Record, s string) error { r.AS = s; return nil }},
+}
+
+// fields available in database type dbt
+func dbmode(dbt uint8) (mode uint32) {
+	for _, f := range fields {
+		if int(dbt) < len(f.position) && f.position[dbt] != 0 {
+			mode |= f.mode
+		}
+	}
+	return
+}
+
+// number of columns of database type dbt, including IPFrom
+func dbcolumns(dbt uint8) (columns uint8) {
+	columns = 1
+	for _, f := range fields {
+		if int(dbt) < len(f.position) && f.position[dbt] > columns {
+			columns = f.position[dbt]
+		}
+	}
+	return
+}
+
+// compare fields selected by mode
+func samerecord(a, b *Record, mode uint32) bool {
+	for _, f := range fields {
+		if mode&f.mode != 0 && f.get(a) != f.get(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// coordinates are written with 6 decimal places, as in IP2Location CSV files
+func formatcoord(v float32) string {
+	return strconv.FormatFloat(coord64(v), 'f', 6, 64)
+}
+
+// parse a numeric column, leaving "-" and empty values as zero
+func parsefloat(v *float32, s string) error {
+	if s == "" || s == "-" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return err
+	}
+	*v = float32(f)
+	return nil
+}
+
+// ParseMode parses a database type such as "DB3", or a list of
+// IP2Location CSV column names such as "country_code,region_name,city_name"
+func ParseMode(s string) (uint32, error) {
+	if len(s) > 2 && strings.EqualFold(s[:2], "DB") {
+		t, err := strconv.Atoi(s[2:])
+		if err != nil || t <= 0 || t >= len(countryPosition) {
+			return 0, ErrNotSupported
+		}
+		return dbmode(uint8(t)), nil
+	}
+	var mode uint32
+	for _, name := range strings.Split(s, ",") {
+		f := fieldbyname(strings.TrimSpace(name))
+		if f == nil {
+			return 0, ErrNotSupported
+		}
+		mode |= f.mode
+	}
+	return mode, nil
+}
+
+// DBType returns the smallest database type carrying every field of mode
+func DBType(mode uint32) (uint8, bool) {
+	var dbt uint8
+	for t := 1; t < len(countryPosition); t++ {
+		m := dbmode(uint8(t))
+		if m&mode == mode && (dbt == 0 || bits.OnesCount32(m) < bits.OnesCount32(dbmode(dbt))) {
+			dbt = uint8(t)
+		}
+	}
+	return dbt, dbt != 0
+}
+
+// copy fields selected by mode
+func copyfields(dst, src *Record, mode uint32) {
+	for _, f := range fields {
+		if mode&f.mode == 0 {
+			continue
+		}
+		switch f.mode {
+		case ModeLatitude:
+			dst.Latitude = src.Latitude
+		case ModeLongitude:
+			dst.Longitude = src.Longitude
+		case ModeElevation:
+			dst.Elevation, dst.elevationerr = src.Elevation, src.elevationerr
+		default:
+			f.set(dst, f.get(src))
+		}
+	}
+}
+
+func fieldbyname(name string) *field {
+	for i := range fields {
+		if fields[i].name == name {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+func fieldbymode(mode uint32) *field {
+	for i := range fields {
+		if fields[i].mode == mode {
+			return &fields[i]
+		}
+	}
+	return nil
+}
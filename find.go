@@ -0,0 +1,65 @@
+package ip2location
+
+import (
+	"math/bits"
+	"net/netip"
+)
+
+// FindRanges returns the CIDR prefixes, IPv4 first, of every address whose `field`, a single field
+// such as ModeCountryShort or ModeISP, equals value; values are compared as written by DB.WriteCSV.
+//
+// The first call for a field scans the database to index it by value, and the prefixes of each
+// value are cached, so later calls are cheap; each call returns a copy the caller may modify.
+// Adjacent ranges are merged before conversion to prefixes.
+func (db *DB) FindRanges(field uint32, value string) ([]netip.Prefix, error) {
+	if bits.OnesCount32(field) != 1 || field&dbmode(db.meta.databasetype) == 0 {
+		return nil, ErrNotSupported
+	}
+	db.findmu.Lock()
+	defer db.findmu.Unlock()
+	if prefixes, ok := db.findcache[field][value]; ok {
+		return append([]netip.Prefix(nil), prefixes...), nil
+	}
+	index, ok := db.findindex[field]
+	if !ok {
+		var err error
+		if index, err = db.buildindex(field); err != nil {
+			return nil, err
+		}
+		if db.findindex == nil {
+			db.findindex = make(map[uint32]map[string][]Range)
+			db.findcache = make(map[uint32]map[string][]netip.Prefix)
+		}
+		db.findindex[field] = index
+		db.findcache[field] = make(map[string][]netip.Prefix)
+	}
+	var prefixes []netip.Prefix
+	for _, r := range index[value] {
+		prefixes = append(prefixes, rangeprefixes(r.Family, r.From, r.To)...)
+	}
+	db.findcache[field][value] = prefixes
+	return append([]netip.Prefix(nil), prefixes...), nil
+}
+
+// merged ranges of every value of field
+func (db *DB) buildindex(field uint32) (map[string][]Range, error) {
+	f := fieldbymode(field)
+	index := make(map[string][]Range)
+	for _, family := range []int{4, 6} {
+		it := db.Ranges(family, field)
+		for it.Next() {
+			r := it.Range()
+			value := f.get(r.Record)
+			ranges := index[value]
+			if n := len(ranges); n > 0 && ranges[n-1].Family == family && ranges[n-1].To.Cmp(r.From) == 0 {
+				ranges[n-1].To = r.To
+				continue
+			}
+			index[value] = append(ranges, Range{Family: family, From: r.From, To: r.To})
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return index, nil
+}
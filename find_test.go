@@ -0,0 +1,48 @@
+package ip2location_test
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestFindRanges(t *testing.T) {
+	db := ip2locationtest.MustNewDB(1, table)
+	defer db.Close()
+	prefixes, err := db.FindRanges(ip2location.ModeCountryShort, "JP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []netip.Prefix{
+		netip.MustParsePrefix("1.0.0.0/24"),
+		netip.MustParsePrefix("255.255.255.0/24"),
+		netip.MustParsePrefix("ffff::/16"),
+	}
+	if !reflect.DeepEqual(prefixes, want) {
+		t.Errorf("got %v, want %v", prefixes, want)
+	}
+
+	// changing a result must not change the cached prefixes
+	prefixes[0] = netip.MustParsePrefix("9.9.9.0/24")
+	_ = append(prefixes[:1], netip.MustParsePrefix("8.8.8.0/24"))
+	again, err := db.FindRanges(ip2location.ModeCountryShort, "JP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again, want) {
+		t.Errorf("after changing a result: got %v, want %v", again, want)
+	}
+
+	if prefixes, err = db.FindRanges(ip2location.ModeCountryShort, "XX"); err != nil || len(prefixes) != 0 {
+		t.Errorf("unknown value: got %v, %v", prefixes, err)
+	}
+	if _, err = db.FindRanges(ip2location.ModeCity, "Tokyo"); err != ip2location.ErrNotSupported {
+		t.Errorf("city of DB1: error %v, want %v", err, ip2location.ErrNotSupported)
+	}
+	if _, err = db.FindRanges(ip2location.ModeCountryShort|ip2location.ModeCountryLong, "JP"); err != ip2location.ErrNotSupported {
+		t.Errorf("two fields: error %v, want %v", err, ip2location.ErrNotSupported)
+	}
+}
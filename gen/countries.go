@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/biter777/countries"
+	_ "github.com/go-playground/locales" // CLDR locales, listed from the module directory
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// members of the European Union and of the European Economic Area
+var (
+	eu  = strings.Fields("AT BE BG HR CY CZ DK EE FI FR DE GR HU IE IT LV LT LU MT NL PL PT RO SK SI ES SE")
+	eea = append(strings.Fields("IS LI NO"), eu...)
+)
+
+// currencies that changed after the CLDR data of golang.org/x/text
+var currencies = map[string]string{
+	"BG": "EUR", // since 2026-01-01
+	"CW": "XCG", // since 2025-03-31
+	"HR": "EUR", // since 2023-01-01
+	"MR": "MRU", // redenominated in 2018
+	"SL": "SLE", // redenominated in 2022
+	"SX": "XCG", // since 2025-03-31
+	"VE": "VES", // redenominated in 2018
+}
+
+// calling codes of territories dialed through the code of another country with an area prefix,
+// the shared country code first
+var callingcodes = map[string][]string{
+	"AX": {"358", "35818"},
+	"CX": {"61", "6189164"},
+	"GG": {"44", "441481"},
+	"IM": {"44", "441624"},
+	"JE": {"44", "441534"},
+	"VA": {"39", "379"},
+	"YT": {"262", "262269", "262639"},
+}
+
+// countries missing from ISO 3166-1
+var extracountries = []country{
+	{"XK", "XKX", "", "Kosovo", "EU", false, false, "EUR", []string{"sq", "sr"}, []string{"383"}},
+}
+
+// a row of countrytable, as ip2location.Country
+type country struct {
+	code, alpha3, numeric, name, continent string
+	eu, eea                                bool
+	currency                               string
+	languages, callingcodes                []string
+}
+
+// countrydata.go, from ISO 3166-1, CLDR and ITU calling codes
+func countrytable(w io.Writer) error {
+	var list []struct {
+		Alpha2     string `json:"alpha_2"`
+		Alpha3     string `json:"alpha_3"`
+		Numeric    string `json:"numeric"`
+		Name       string `json:"name"`
+		CommonName string `json:"common_name"`
+	}
+	if err := readisocodes("3166-1", &list); err != nil {
+		return err
+	}
+	locales, err := regionlocales()
+	if err != nil {
+		return err
+	}
+	rows := extracountries
+	for _, c := range list {
+		name := c.Name
+		if c.CommonName != "" {
+			name = c.CommonName
+		}
+		rows = append(rows, country{
+			code:         c.Alpha2,
+			alpha3:       c.Alpha3,
+			numeric:      c.Numeric,
+			name:         name,
+			continent:    continent(c.Alpha2),
+			eu:           contains(eu, c.Alpha2),
+			eea:          contains(eea, c.Alpha2),
+			currency:     regioncurrency(c.Alpha2),
+			languages:    languages(c.Alpha2, locales[c.Alpha2]),
+			callingcodes: calls(c.Alpha2),
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].code < rows[j].code })
+
+	fmt.Fprint(w, "// Code generated by gen from ISO 3166-1 (iso-codes), CLDR and ITU calling codes; DO NOT EDIT.\n\n")
+	fmt.Fprint(w, "package ip2location\n\n")
+	fmt.Fprint(w, "// reference data of countries by ISO 3166-1 alpha-2 code\n")
+	fmt.Fprint(w, "var countrytable = map[string]Country{\n")
+	for _, c := range rows {
+		fmt.Fprintf(w, "\t%q: {%q, %q, %q, %q, %q, %v, %v, %q, %s, %s},\n", c.code, c.code, c.alpha3, c.numeric, c.name,
+			c.continent, c.eu, c.eea, c.currency, stringslice(c.languages), stringslice(c.callingcodes))
+	}
+	fmt.Fprint(w, "}\n")
+	return nil
+}
+
+// languages of the CLDR locales of each region, e.g. "fr" and "de" for "CH", from the
+// directories of the locales module, named after their locale such as "fr_CH"
+func regionlocales() (map[string][]string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/go-playground/locales").Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating the locales module: %w", err)
+	}
+	entries, err := os.ReadDir(strings.TrimSpace(string(out)))
+	if err != nil {
+		return nil, err
+	}
+	locales := make(map[string][]string)
+	for _, e := range entries {
+		i := strings.LastIndexByte(e.Name(), '_')
+		if !e.IsDir() || i < 0 {
+			continue
+		}
+		lang, region := e.Name()[:i], e.Name()[i+1:]
+		if len(region) != 2 || strings.ToUpper(region) != region || strings.Contains(lang, "_") {
+			continue // a region such as "001", or a script such as "sr_Latn"
+		}
+		locales[region] = append(locales[region], lang)
+	}
+	return locales, nil
+}
+
+// the main language of the region, then the others of its locales but English
+func languages(region string, locales []string) []string {
+	var langs []string
+	if t, err := language.Parse("und-" + region); err == nil {
+		if base, _ := t.Base(); base.String() != "und" {
+			langs = append(langs, base.String())
+		}
+	}
+	sort.Strings(locales)
+	for _, l := range locales {
+		if (len(langs) == 0 || l != "en") && !contains(langs, l) {
+			langs = append(langs, l)
+		}
+	}
+	return langs
+}
+
+// the continent code of a region, from the UN M49 areas of CLDR
+func continent(region string) string {
+	if region == "AQ" {
+		return "AN"
+	}
+	r, err := language.ParseRegion(region)
+	if err != nil {
+		return ""
+	}
+	for _, area := range []struct{ m49, continent string }{
+		{"002", "AF"}, {"142", "AS"}, {"150", "EU"}, {"009", "OC"}, {"005", "SA"},
+		{"021", "NA"}, {"013", "NA"}, {"029", "NA"}, // Northern America, Central America and the Caribbean
+	} {
+		if language.MustParseRegion(area.m49).Contains(r) {
+			return area.continent
+		}
+	}
+	return ""
+}
+
+func regioncurrency(region string) string {
+	if c, ok := currencies[region]; ok {
+		return c
+	}
+	r, err := language.ParseRegion(region)
+	if err != nil {
+		return ""
+	}
+	if u, ok := currency.FromRegion(r); ok {
+		return u.String()
+	}
+	return ""
+}
+
+func calls(region string) []string {
+	if codes, ok := callingcodes[region]; ok {
+		return codes
+	}
+	var codes []string
+	for _, c := range countries.ByName(region).CallCodes() {
+		if c != 0 {
+			codes = append(codes, strings.TrimPrefix(c.String(), "+"))
+		}
+	}
+	return codes
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// a []string literal, or nil
+func stringslice(list []string) string {
+	if len(list) == 0 {
+		return "nil"
+	}
+	quoted := make([]string, len(list))
+	for i, s := range list {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
@@ -0,0 +1,9 @@
+module github.com/zyxar/ip2location-go/gen
+
+go 1.25.0
+
+require (
+	github.com/biter777/countries v1.7.5
+	github.com/go-playground/locales v0.14.1
+	golang.org/x/text v0.40.0
+)
@@ -0,0 +1,76 @@
+// Command gen writes the generated reference tables of package ip2location:
+//
+//	gen -o countrydata.go countries
+//	gen -o subdivisiondata.go subdivisions
+//	gen -o tzzones.go timezones
+//
+// ISO 3166 codes are read from the iso-codes package of the system, and time zones from its
+// zoneinfo. It is a module of its own, so that package ip2location does not depend on the data
+// modules; run go generate in the ip2location directory to refresh every table.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+var (
+	output   = flag.String("o", "", "output file, default standard output")
+	isocodes = flag.String("isocodes", "/usr/share/iso-codes/json", "directory of the iso-codes JSON files")
+	zoneinfo = flag.String("zoneinfo", "/usr/share/zoneinfo", "directory of the tz database, with zone.tab and tzdata.zi")
+)
+
+var generators = map[string]func(w io.Writer) error{
+	"countries":    countrytable,
+	"subdivisions": subdivisiontable,
+	"timezones":    tzzones,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	flag.Parse()
+	if flag.NArg() != 1 || generators[flag.Arg(0)] == nil {
+		log.Fatal("usage: gen [-o file] countries|subdivisions|timezones")
+	}
+	var buf bytes.Buffer
+	if err := generators[flag.Arg(0)](&buf); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*output, src, 0o644)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// decode the list of an iso-codes JSON file, e.g. "3166-1" of iso_3166-1.json
+func readisocodes(standard string, v interface{}) error {
+	data, err := os.ReadFile(filepath.Join(*isocodes, "iso_"+standard+".json"))
+	if err != nil {
+		return err
+	}
+	var file map[string]json.RawMessage
+	if err = json.Unmarshal(data, &file); err != nil {
+		return err
+	}
+	list, ok := file[standard]
+	if !ok {
+		return fmt.Errorf("no %s list in iso-codes", standard)
+	}
+	return json.Unmarshal(list, v)
+}
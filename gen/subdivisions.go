@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/runenames"
+)
+
+// suffixes of the administrative type of the ISO 3166-2 names of China, which IP2Location leaves
+// out: "Beijing" for "Beijing Shi", "Guangxi Zhuangzu" for "Guangxi Zhuangzu Zizhiqu"
+var chinasuffixes = []string{" Shi", " Sheng", " Zizhiqu", " SAR"}
+
+// other names of subdivisions, such as those used by IP2Location
+var aliases = []subdivision{
+	{"CN-GX", "Guangxi"},
+	{"CN-MO", "Macau"},
+	{"CN-NM", "Inner Mongolia"},
+	{"CN-NX", "Ningxia"},
+	{"CN-NX", "Ningxia Huizu"}, // "Huizi" in ISO 3166-2
+	{"CN-XJ", "Xinjiang"},
+	{"CN-XZ", "Tibet"},
+}
+
+// base letters of Unicode names without a decomposition, such as "LATIN SMALL LETTER SHARP S"
+var foldnames = map[string]string{
+	"SHARP S": "ss",
+	"ETH":     "d",
+	"THORN":   "th",
+	"ENG":     "n",
+	"KRA":     "k",
+}
+
+type subdivision struct{ code, name string }
+
+// subdivisiondata.go, from ISO 3166-2 and the Unicode decompositions of Latin letters
+func subdivisiontable(w io.Writer) error {
+	var list []struct {
+		Code   string `json:"code"`
+		Name   string `json:"name"`
+		Parent string `json:"parent"`
+	}
+	if err := readisocodes("3166-2", &list); err != nil {
+		return err
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if (list[i].Parent == "") != (list[j].Parent == "") {
+			return list[i].Parent == ""
+		}
+		return list[i].Code < list[j].Code
+	})
+	var rows, china []subdivision
+	for _, s := range list {
+		rows = append(rows, subdivision{s.Code, s.Name})
+		if alias, ok := chinaalias(s.Code, s.Name); ok && strings.HasPrefix(s.Code, "CN-") {
+			china = append(china, alias)
+		}
+	}
+	rows = append(append(rows, china...), aliases...)
+
+	fmt.Fprint(w, "// Code generated by gen from ISO 3166-2 (iso-codes) and Unicode; DO NOT EDIT.\n\n")
+	fmt.Fprint(w, "package ip2location\n\n")
+	fmt.Fprint(w, "// ISO 3166-2 subdivisions and their names, top-level subdivisions first, then other names\n")
+	fmt.Fprint(w, "var subdivisiontable = [...]struct{ code, name string }{\n")
+	for _, s := range rows {
+		fmt.Fprintf(w, "\t{%q, %q},\n", s.code, s.name)
+	}
+	fmt.Fprint(w, "}\n\n")
+	fmt.Fprint(w, "// ASCII letters of U+00C0 to U+024F without their diacritics, see foldname\n")
+	writefold(w, "foldlatin", 0xC0, 0x250)
+	fmt.Fprint(w, "// ASCII letters of U+1E00 to U+1EFF without their diacritics, see foldname\n")
+	writefold(w, "foldlatinadditional", 0x1E00, 0x1F00)
+	return nil
+}
+
+// the name of a subdivision of China without its administrative type
+func chinaalias(code, name string) (subdivision, bool) {
+	for _, suffix := range chinasuffixes {
+		if short := strings.TrimSuffix(name, suffix); short != name {
+			return subdivision{code, short}, true
+		}
+	}
+	return subdivision{}, false
+}
+
+// the fold table of the runes from to to, exclusive
+func writefold(w io.Writer, name string, from, to rune) {
+	fmt.Fprintf(w, "var %s = [...]string{\n", name)
+	for r := from; r < to; r += 16 {
+		fmt.Fprint(w, "\t")
+		for c := r; c < r+16 && c < to; c++ {
+			fmt.Fprintf(w, "%q, ", fold(c))
+		}
+		fmt.Fprintf(w, "// U+%04X\n", r)
+	}
+	fmt.Fprint(w, "}\n\n")
+}
+
+// the lower case ASCII letters of a Latin letter: those of its compatibility decomposition, such
+// as "e" for 'é' or "dz" for 'ǆ', otherwise the base letter of its Unicode name, such as "o" for
+// "LATIN SMALL LETTER O WITH STROKE" or "ae" for "LATIN CAPITAL LETTER AE"; empty for other runes
+func fold(r rune) string {
+	if s := asciiletters(norm.NFKD.String(string(r))); s != "" {
+		return s
+	}
+	name := runenames.Name(r)
+	for _, prefix := range []string{"LATIN SMALL LETTER ", "LATIN CAPITAL LETTER ", "LATIN SMALL LIGATURE ", "LATIN CAPITAL LIGATURE ", "LATIN LETTER "} {
+		if rest := strings.TrimPrefix(name, prefix); rest != name {
+			name, _, _ = strings.Cut(rest, " WITH ")
+			if s, ok := foldnames[name]; ok {
+				return s
+			}
+			words := strings.Fields(name) // such as "SMALL CAPITAL R" or "TURNED A"
+			if last := words[len(words)-1]; len(last) <= 2 {
+				return asciiletters(last)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+func asciiletters(s string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(s) {
+		if c >= 'a' && c <= 'z' {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
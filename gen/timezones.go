@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// a zone.tab coordinate, ±DDMM±DDDMM or ±DDMMSS±DDDMMSS
+var zonecoords = regexp.MustCompile(`^([+-])(\d{2})(\d{2})(\d{2})?([+-])(\d{3})(\d{2})(\d{2})?$`)
+
+// tzzones.go, from zone.tab
+func tzzones(w io.Writer) error {
+	version, err := tzversion()
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(filepath.Join(*zoneinfo, "zone.tab"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(w, "// Code generated by gen from zone.tab of the tz database, version %s; DO NOT EDIT.\n\n", version)
+	fmt.Fprint(w, "package ip2location\n\n")
+	fmt.Fprint(w, "// IANA time zones by country, with the coordinates of their principal location\n")
+	fmt.Fprint(w, "var tzzones = []tzzone{\n")
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if strings.HasPrefix(s.Text(), "#") {
+			continue
+		}
+		cols := strings.Split(s.Text(), "\t")
+		if len(cols) < 3 {
+			return fmt.Errorf("zone.tab: invalid line %q", s.Text())
+		}
+		m := zonecoords.FindStringSubmatch(cols[1])
+		if m == nil {
+			return fmt.Errorf("zone.tab: invalid coordinates %q", cols[1])
+		}
+		lat, lon := degrees(m[1], m[2], m[3], m[4]), degrees(m[5], m[6], m[7], m[8])
+		fmt.Fprintf(w, "\t{%q, %s, %s, %q},\n", cols[0], lat, lon, cols[2])
+	}
+	if err = s.Err(); err != nil {
+		return err
+	}
+	fmt.Fprint(w, "}\n")
+	return nil
+}
+
+// the version of the tz database, from the first line of tzdata.zi, e.g. "# version 2025b"
+func tzversion() (string, error) {
+	data, err := os.ReadFile(filepath.Join(*zoneinfo, "tzdata.zi"))
+	if err != nil {
+		return "", err
+	}
+	line, _, _ := strings.Cut(string(data), "\n")
+	version := strings.TrimPrefix(line, "# version ")
+	if version == line {
+		return "", fmt.Errorf("tzdata.zi: no version")
+	}
+	return version, nil
+}
+
+// decimal degrees of a zone.tab coordinate, to 4 places
+func degrees(sign, deg, min, sec string) string {
+	d, _ := strconv.Atoi(deg)
+	m, _ := strconv.Atoi(min)
+	s, _ := strconv.Atoi(sec) // 0 if absent
+	v := float64(d) + float64(m)/60 + float64(s)/3600
+	if sign == "-" {
+		v = -v
+	}
+	return strconv.FormatFloat(math.Round(v*1e4)/1e4, 'f', -1, 64)
+}
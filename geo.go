@@ -0,0 +1,161 @@
+package ip2location
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrNoCoordinates      = errors.New("No coordinates")
+	ErrInvalidCoordinates = errors.New("Invalid coordinates")
+)
+
+const earthradius = 6371.0088 // mean radius in km
+
+// BoundingBox is an area between two latitudes and two longitudes, in degrees; a box
+// crossing the antimeridian has MinLongitude greater than MaxLongitude
+type BoundingBox struct {
+	MinLatitude, MinLongitude float64
+	MaxLatitude, MaxLongitude float64
+}
+
+// Contains reports whether the point at lat, lon is inside b, edges included
+func (b BoundingBox) Contains(lat, lon float64) bool {
+	if lat < b.MinLatitude || lat > b.MaxLatitude {
+		return false
+	}
+	if b.MinLongitude <= b.MaxLongitude {
+		return lon >= b.MinLongitude && lon <= b.MaxLongitude
+	}
+	return lon >= b.MinLongitude || lon <= b.MaxLongitude
+}
+
+// Coordinates returns r.Latitude and r.Longitude as float64, without float32 rounding noise;
+// ok is false if the lookup did not read them, the database has none for the address (both
+// are zero), or they are out of range
+func (r *Record) Coordinates() (lat, lon float64, ok bool) {
+	if r.Mode != 0 && r.Mode&(ModeLatitude|ModeLongitude) != ModeLatitude|ModeLongitude {
+		return 0, 0, false
+	}
+	if r.Latitude == 0 && r.Longitude == 0 {
+		return 0, 0, false
+	}
+	lat, lon = coord64(r.Latitude), coord64(r.Longitude)
+	return lat, lon, validcoords(lat, lon)
+}
+
+// Distance returns the great-circle distance in km from r to other
+func (r *Record) Distance(other *Record) (float64, error) {
+	lat, lon, ok := other.Coordinates()
+	if !ok {
+		return 0, ErrNoCoordinates
+	}
+	return r.DistanceTo(lat, lon)
+}
+
+// DistanceTo returns the great-circle distance in km from r to the point at lat, lon,
+// using the haversine formula
+func (r *Record) DistanceTo(lat, lon float64) (float64, error) {
+	lat1, lon1, err := r.checkcoords(lat, lon)
+	if err != nil {
+		return 0, err
+	}
+	return haversine(lat1, lon1, lat, lon), nil
+}
+
+// Bearing returns the initial bearing in degrees clockwise from north, from 0 up to 360,
+// of the great circle from r to other
+func (r *Record) Bearing(other *Record) (float64, error) {
+	lat, lon, ok := other.Coordinates()
+	if !ok {
+		return 0, ErrNoCoordinates
+	}
+	return r.BearingTo(lat, lon)
+}
+
+// BearingTo returns the initial bearing from r to the point at lat, lon, see Bearing
+func (r *Record) BearingTo(lat, lon float64) (float64, error) {
+	lat1, lon1, err := r.checkcoords(lat, lon)
+	if err != nil {
+		return 0, err
+	}
+	lat1r, lat2r := lat1*math.Pi/180, lat*math.Pi/180
+	dlon := (lon - lon1) * math.Pi / 180
+	y := math.Sin(dlon) * math.Cos(lat2r)
+	x := math.Cos(lat1r)*math.Sin(lat2r) - math.Sin(lat1r)*math.Cos(lat2r)*math.Cos(dlon)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360), nil
+}
+
+const geohashbase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash returns the geohash of r's coordinates with precision characters, from 1 (about
+// 5000 km) to 12 (about 4 cm)
+func (r *Record) Geohash(precision int) (string, error) {
+	if precision < 1 || precision > 12 {
+		return "", ErrNotSupported
+	}
+	lat, lon, ok := r.Coordinates()
+	if !ok {
+		return "", ErrNoCoordinates
+	}
+	latrange, lonrange := [2]float64{-90, 90}, [2]float64{-180, 180}
+	hash := make([]byte, precision)
+	even := true // bits alternate between longitude and latitude, longitude first
+	for i := range hash {
+		var c byte
+		for bit := 0; bit < 5; bit++ {
+			v, rng := lon, &lonrange
+			if !even {
+				v, rng = lat, &latrange
+			}
+			mid := (rng[0] + rng[1]) / 2
+			c <<= 1
+			if v >= mid {
+				c |= 1
+				rng[0] = mid
+			} else {
+				rng[1] = mid
+			}
+			even = !even
+		}
+		hash[i] = geohashbase32[c]
+	}
+	return string(hash), nil
+}
+
+// Within reports whether r's coordinates are inside b; false if r has no coordinates
+func (r *Record) Within(b BoundingBox) bool {
+	lat, lon, ok := r.Coordinates()
+	return ok && b.Contains(lat, lon)
+}
+
+// coordinates of r, after checking those of the other end
+func (r *Record) checkcoords(lat, lon float64) (float64, float64, error) {
+	if !validcoords(lat, lon) {
+		return 0, 0, ErrInvalidCoordinates
+	}
+	lat1, lon1, ok := r.Coordinates()
+	if !ok {
+		return 0, 0, ErrNoCoordinates
+	}
+	return lat1, lon1, nil
+}
+
+func validcoords(lat, lon float64) bool {
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+// a coordinate as float64, through its shortest float32 representation, so that 37.4 stays 37.4
+func coord64(v float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'f', -1, 32), 64)
+	return f
+}
+
+// great-circle distance in km between two points given in degrees
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	lat1r, lat2r := lat1*math.Pi/180, lat2*math.Pi/180
+	dlat, dlon := lat2r-lat1r, (lon2-lon1)*math.Pi/180
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1r)*math.Cos(lat2r)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	return 2 * earthradius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
@@ -0,0 +1,86 @@
+package ip2location_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+func TestGeohash(t *testing.T) {
+	for _, tt := range []struct {
+		lat, lon  float32
+		precision int
+		want      string
+		err       error
+	}{
+		{57.64911, 10.40744, 11, "u4pruydqqvj", nil},
+		{57.64911, 10.40744, 1, "u", nil},
+		{40.7128, -74.006, 9, "dr5regw3p", nil},
+		{-33.8688, 151.2093, 6, "r3gx2f", nil},
+		{57.64911, 10.40744, 0, "", ip2location.ErrNotSupported},
+		{57.64911, 10.40744, 13, "", ip2location.ErrNotSupported},
+		{0, 0, 5, "", ip2location.ErrNoCoordinates},
+	} {
+		r := ip2location.Record{Latitude: tt.lat, Longitude: tt.lon}
+		got, err := r.Geohash(tt.precision)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("%v,%v precision %d: got %q, %v, want %q, %v", tt.lat, tt.lon, tt.precision, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestDistanceBearing(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		lat, lon          float32
+		tolat, tolon      float64
+		distance, bearing float64
+		err               error
+	}{
+		{"north", 0.5, 0.5, 1.5, 0.5, 111.195, 0, nil},
+		{"east along the equator", 0, 0.5, 0, 1.5, 111.195, 90, nil},
+		{"south", 1.5, 0.5, 0.5, 0.5, 111.195, 180, nil},
+		{"west across the antimeridian", 0, -179.5, 0, 179.5, 111.195, 270, nil},
+		{"Paris to London", 48.8566, 2.3522, 51.5074, -0.1278, 343.6, 330.0, nil},
+		{"no coordinates", 0, 0, 10, 10, 0, 0, ip2location.ErrNoCoordinates},
+		{"invalid target", 10, 10, 91, 0, 0, 0, ip2location.ErrInvalidCoordinates},
+	} {
+		r := ip2location.Record{Latitude: tt.lat, Longitude: tt.lon}
+		d, err := r.DistanceTo(tt.tolat, tt.tolon)
+		if !errors.Is(err, tt.err) || math.Abs(d-tt.distance) > 0.5 {
+			t.Errorf("%s: distance %v, %v, want %v, %v", tt.name, d, err, tt.distance, tt.err)
+		}
+		b, err := r.BearingTo(tt.tolat, tt.tolon)
+		if !errors.Is(err, tt.err) || math.Abs(b-tt.bearing) > 0.5 {
+			t.Errorf("%s: bearing %v, %v, want %v, %v", tt.name, b, err, tt.bearing, tt.err)
+		}
+	}
+	if _, err := paris.Distance(&ip2location.Record{}); err != ip2location.ErrNoCoordinates {
+		t.Errorf("to a record without coordinates: got %v, want ErrNoCoordinates", err)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	europe := ip2location.BoundingBox{MinLatitude: 35, MinLongitude: -10, MaxLatitude: 70, MaxLongitude: 40}
+	pacific := ip2location.BoundingBox{MinLatitude: -50, MinLongitude: 170, MaxLatitude: 0, MaxLongitude: -170}
+	for _, tt := range []struct {
+		name string
+		r    ip2location.Record
+		b    ip2location.BoundingBox
+		want bool
+	}{
+		{"inside", paris, europe, true},
+		{"outside", tokyo, europe, false},
+		{"on the edge", ip2location.Record{Latitude: 35, Longitude: 40}, europe, true},
+		{"across the antimeridian, east", ip2location.Record{Latitude: -20, Longitude: 175}, pacific, true},
+		{"across the antimeridian, west", ip2location.Record{Latitude: -20, Longitude: -175}, pacific, true},
+		{"across the antimeridian, outside", ip2location.Record{Latitude: -20, Longitude: 160}, pacific, false},
+		{"no coordinates", ip2location.Record{}, europe, false},
+	} {
+		if got := tt.r.Within(tt.b); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package ip2location
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+)
+
+// GeoMapFormat selects the output of WriteGeoMap
+type GeoMapFormat int
+
+const (
+	GeoMapNginx   GeoMapFormat = iota // lines of a geo block: 1.0.0.0/24 AU;
+	GeoMapHAProxy                     // a map file for map_ip: 1.0.0.0/24 AU
+	GeoMapApache                      // SetEnvIfExpr directives: SetEnvIfExpr "-R '1.0.0.0/24'" "GEO=AU"
+)
+
+// GeoMapOptions configures WriteGeoMap
+type GeoMapOptions struct {
+	Field  uint32 // a single field, e.g. ModeRegion; default ModeCountryShort
+	Format GeoMapFormat
+	Family int    // 4 or 6; 0 writes both
+	Name   string // environment variable set by GeoMapApache, default "GEO"
+}
+
+// WriteGeoMap writes the value of a field for every address in a map file format loaded by
+// web servers and proxies. Adjacent ranges with the same value are merged into the fewest CIDR
+// prefixes, and written in ascending order, IPv4 first; ranges without a value are left out,
+// so that the default of the map applies. A nil opts writes country codes for nginx.
+func WriteGeoMap(w io.Writer, db *DB, opts *GeoMapOptions) error {
+	if opts == nil {
+		opts = &GeoMapOptions{}
+	}
+	field := opts.Field
+	if field == 0 {
+		field = ModeCountryShort
+	}
+	if bits.OnesCount32(field) != 1 || field&dbmode(db.meta.databasetype) == 0 {
+		return ErrNotSupported
+	}
+	families := []int{4, 6}
+	switch opts.Family {
+	case 0:
+		if db.meta.ipv6databasecount == 0 {
+			families = families[:1]
+		}
+	case 4, 6:
+		families = []int{opts.Family}
+	default:
+		return ErrInvalidFamily
+	}
+	name := opts.Name
+	if name == "" {
+		name = "GEO"
+	}
+	f := fieldbymode(field)
+
+	bw := bufio.NewWriter(w)
+	for _, family := range families {
+		prefixes, err := Aggregate(db.Ranges(family, field))
+		if err != nil {
+			return err
+		}
+		for _, p := range prefixes {
+			value := f.get(p.Record)
+			if value == "" || value == "-" {
+				continue
+			}
+			switch opts.Format {
+			case GeoMapNginx:
+				fmt.Fprintf(bw, "%s %s;\n", p.Prefix, nginxquote(value))
+			case GeoMapHAProxy:
+				fmt.Fprintf(bw, "%s %s\n", p.Prefix, value)
+			case GeoMapApache:
+				fmt.Fprintf(bw, "SetEnvIfExpr \"-R '%s'\" %s\n", p.Prefix, apachequote(name+"="+value))
+			default:
+				return ErrNotSupported
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// quote s if nginx would not read it as a single token
+func nginxquote(s string) string {
+	if !strings.ContainsAny(s, " \t\"';{}#$") {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// quote an Apache directive argument
+func apachequote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
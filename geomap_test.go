@@ -0,0 +1,69 @@
+package ip2location_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestWriteGeoMap(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		city   string
+		format ip2location.GeoMapFormat
+		want   string
+	}{
+		{"nginx", "Paris", ip2location.GeoMapNginx, "1.0.0.0/24 Paris;\n"},
+		{"nginx space", "New York", ip2location.GeoMapNginx, "1.0.0.0/24 \"New York\";\n"},
+		{"nginx quotes", `Say "hi"`, ip2location.GeoMapNginx, `1.0.0.0/24 "Say \"hi\"";` + "\n"},
+		{"nginx semicolon", "a;b", ip2location.GeoMapNginx, "1.0.0.0/24 \"a;b\";\n"},
+		{"nginx apostrophe", "L'Aquila", ip2location.GeoMapNginx, "1.0.0.0/24 \"L'Aquila\";\n"},
+		{"nginx backslash", `a\b c`, ip2location.GeoMapNginx, `1.0.0.0/24 "a\\b c";` + "\n"},
+		{"haproxy", "New York", ip2location.GeoMapHAProxy, "1.0.0.0/24 New York\n"},
+		{"apache", "Paris", ip2location.GeoMapApache, `SetEnvIfExpr "-R '1.0.0.0/24'" "GEO=Paris"` + "\n"},
+		{"apache quotes", `Say "hi"`, ip2location.GeoMapApache, `SetEnvIfExpr "-R '1.0.0.0/24'" "GEO=Say \"hi\""` + "\n"},
+		{"apache backslash", `a\b`, ip2location.GeoMapApache, `SetEnvIfExpr "-R '1.0.0.0/24'" "GEO=a\\b"` + "\n"},
+		{"no value", "-", ip2location.GeoMapNginx, ""},
+	} {
+		db := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+			"1.0.0.0/24": {CountryShort: "XX", City: tt.city},
+		})
+		var buf bytes.Buffer
+		err := ip2location.WriteGeoMap(&buf, db, &ip2location.GeoMapOptions{Field: ip2location.ModeCity, Format: tt.format, Family: 4})
+		db.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, buf.String(), tt.want)
+		}
+	}
+}
+
+func TestWriteGeoMapMerge(t *testing.T) {
+	db := ip2locationtest.MustNewDB(1, ip2locationtest.Table{
+		"1.0.0.0/24":    tokyo,
+		"1.0.1.0/24":    tokyo,
+		"1.0.2.0/24":    paris,
+		"2001:db8::/32": paris,
+	})
+	defer db.Close()
+	var buf bytes.Buffer
+	if err := ip2location.WriteGeoMap(&buf, db, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := "1.0.0.0/23 JP;\n1.0.2.0/24 FR;\n2001:db8::/32 FR;\n"
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+	for _, opts := range []*ip2location.GeoMapOptions{
+		{Field: ip2location.ModeCity},
+		{Field: ip2location.ModeCountryShort | ip2location.ModeCountryLong},
+	} {
+		if err := ip2location.WriteGeoMap(new(bytes.Buffer), db, opts); err != ip2location.ErrNotSupported {
+			t.Errorf("field %#x: got %v, want ErrNotSupported", opts.Field, err)
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/zyxar/ip2location-go
 
-go 1.12
+go 1.18
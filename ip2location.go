@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"io"
 	"math/big"
 	"net"
+	"net/netip"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 )
 
 type Record struct {
@@ -31,28 +35,42 @@ type Record struct {
 	MobileBrand        string
 	Elevation          float32
 	UsageType          string
+	AddressType        string // A (anycast), U (unicast), M (multicast) or B (broadcast)
+	Category           string // IAB content taxonomy, e.g. IAB19
+	District           string
+	ASN                string
+	AS                 string
+
+	Mode uint32 // fields populated by the lookup, with ModePopulated; zero for a Record built by hand, meaning every field
+
+	elevationerr error // malformed elevation in the database, see ElevationMeters
 }
 
 var (
-	countryPosition            = [25]uint8{0, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
-	regionPosition             = [25]uint8{0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
-	cityPosition               = [25]uint8{0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
-	ispPosition                = [25]uint8{0, 0, 3, 0, 5, 0, 7, 5, 7, 0, 8, 0, 9, 0, 9, 0, 9, 0, 9, 7, 9, 0, 9, 7, 9}
-	latitudePosition           = [25]uint8{0, 0, 0, 0, 0, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5}
-	longitudePosition          = [25]uint8{0, 0, 0, 0, 0, 6, 6, 0, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6}
-	domainPosition             = [25]uint8{0, 0, 0, 0, 0, 0, 0, 6, 8, 0, 9, 0, 10, 0, 10, 0, 10, 0, 10, 8, 10, 0, 10, 8, 10}
-	zipcodePosition            = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 7, 0, 7, 7, 7, 0, 7, 0, 7, 7, 7, 0, 7}
-	timezonePosition           = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 8, 7, 8, 8, 8, 7, 8, 0, 8, 8, 8, 0, 8}
-	netspeedPosition           = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 11, 0, 11, 8, 11, 0, 11, 0, 11, 0, 11}
-	iddcodePosition            = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 12, 0, 12, 0, 12, 9, 12, 0, 12}
-	areacodePosition           = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 13, 0, 13, 0, 13, 10, 13, 0, 13}
-	weatherstationcodePosition = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 14, 0, 14, 0, 14, 0, 14}
-	weatherstationnamePosition = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 15, 0, 15, 0, 15, 0, 15}
-	mccPosition                = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 16, 0, 16, 9, 16}
-	mncPosition                = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 17, 0, 17, 10, 17}
-	mobilebrandPosition        = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 18, 0, 18, 11, 18}
-	elevationPosition          = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 19, 0, 19}
-	usagetypePosition          = [25]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 20}
+	countryPosition            = [27]uint8{0, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	regionPosition             = [27]uint8{0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
+	cityPosition               = [27]uint8{0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+	ispPosition                = [27]uint8{0, 0, 3, 0, 5, 0, 7, 5, 7, 0, 8, 0, 9, 0, 9, 0, 9, 0, 9, 7, 9, 0, 9, 7, 9, 9, 9}
+	latitudePosition           = [27]uint8{0, 0, 0, 0, 0, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5}
+	longitudePosition          = [27]uint8{0, 0, 0, 0, 0, 6, 6, 0, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6}
+	domainPosition             = [27]uint8{0, 0, 0, 0, 0, 0, 0, 6, 8, 0, 9, 0, 10, 0, 10, 0, 10, 0, 10, 8, 10, 0, 10, 8, 10, 10, 10}
+	zipcodePosition            = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 7, 0, 7, 7, 7, 0, 7, 0, 7, 7, 7, 0, 7, 7, 7}
+	timezonePosition           = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 8, 7, 8, 8, 8, 7, 8, 0, 8, 8, 8, 0, 8, 8, 8}
+	netspeedPosition           = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 11, 0, 11, 8, 11, 0, 11, 0, 11, 0, 11, 11, 11}
+	iddcodePosition            = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 12, 0, 12, 0, 12, 9, 12, 0, 12, 12, 12}
+	areacodePosition           = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 13, 0, 13, 0, 13, 10, 13, 0, 13, 13, 13}
+	weatherstationcodePosition = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 14, 0, 14, 0, 14, 0, 14, 14, 14}
+	weatherstationnamePosition = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 15, 0, 15, 0, 15, 0, 15, 15, 15}
+	mccPosition                = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 16, 0, 16, 9, 16, 16, 16}
+	mncPosition                = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 17, 0, 17, 10, 17, 17, 17}
+	mobilebrandPosition        = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 18, 0, 18, 11, 18, 18, 18}
+	elevationPosition          = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 19, 0, 19, 19, 19}
+	usagetypePosition          = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 20, 20, 20}
+	addresstypePosition        = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21}
+	categoryPosition           = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 22, 22}
+	districtPosition           = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23}
+	asnPosition                = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 24}
+	asPosition                 = [27]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 25}
 )
 
 var (
@@ -62,6 +80,7 @@ var (
 	ErrInvalidAddress = errors.New("Invalid IP address")
 	ErrInvalidFile    = errors.New("Invalid database file")
 	ErrNotSupported   = errors.New("Unsupported feature for selected data file")
+	ErrWrongProduct   = errors.New("Database file is of another product")
 )
 
 const (
@@ -116,8 +135,22 @@ const (
 	ModeDB24 = ModeDB22 | ModeUsageType                                                   //ip country region city latitude longitude zipcode timezone isp domain netspeed areacode weather mobile elevation usagetype
 )
 
+// fields of DB25 and DB26; ModeASN and ModeAS are shared with IP2Proxy databases
+const (
+	ModeAddressType uint32 = 1 << (26 + iota)
+	ModeCategory
+	ModeDistrict
+
+	ModeDB25 = ModeDB24 | ModeAddressType | ModeCategory  //ip country region city latitude longitude zipcode timezone isp domain netspeed areacode weather mobile elevation usagetype addresstype category
+	ModeDB26 = ModeDB25 | ModeDistrict | ModeASN | ModeAS //ip country region city latitude longitude zipcode timezone isp domain netspeed areacode weather mobile elevation usagetype addresstype category district asn as
+)
+
+// ModePopulated is set in Record.Mode by lookups and decoding, so that a Record with none of
+// the requested fields is told apart from one built by hand, whose zero Mode means every field
+const ModePopulated uint32 = 1 << 31
+
 type DB struct {
-	f    *os.File
+	f    io.ReaderAt
 	meta ip2locationmeta
 
 	countryPositionOffset            uint32
@@ -139,6 +172,11 @@ type DB struct {
 	mobilebrandPositionOffset        uint32
 	elevationPositionOffset          uint32
 	usagetypePositionOffset          uint32
+	addresstypePositionOffset        uint32
+	categoryPositionOffset           uint32
+	districtPositionOffset           uint32
+	asnPositionOffset                uint32
+	asPositionOffset                 uint32
 
 	countryEnabled            bool
 	regionEnabled             bool
@@ -159,14 +197,25 @@ type DB struct {
 	mobilebrandEnabled        bool
 	elevationEnabled          bool
 	usagetypeEnabled          bool
+	addresstypeEnabled        bool
+	categoryEnabled           bool
+	districtEnabled           bool
+	asnEnabled                bool
+	asEnabled                 bool
+
+	mode      uint32 // fields of the database type
+	normalize bool   // empty placeholder values, see SetNormalize
+
+	findmu           sync.Mutex
+	findindex        map[uint32]map[string][]Range        // merged ranges by field and value, see FindRanges
+	findcache        map[uint32]map[string][]netip.Prefix // prefixes by field and value
+	subdivisionindex map[string][]Range                   // merged ranges by ISO 3166-2 code, see SubdivisionRanges
 }
 
 // get IP type and calculate IP number; calculates index too if exists
 func checkip(meta *ip2locationmeta, ip string) (iptype uint32, ipnum *big.Int, ipindex uint32) {
 	iptype = 0
 	ipnum = big.NewInt(0)
-	ipnumtmp := big.NewInt(0)
-	ipindex = 0
 	ipaddress := net.ParseIP(ip)
 
 	if ipaddress != nil {
@@ -184,6 +233,13 @@ func checkip(meta *ip2locationmeta, ip string) (iptype uint32, ipnum *big.Int, i
 			}
 		}
 	}
+	ipindex = checkindex(meta, iptype, ipnum)
+	return
+}
+
+// calculate index address of IP number, if the index exists
+func checkindex(meta *ip2locationmeta, iptype uint32, ipnum *big.Int) (ipindex uint32) {
+	ipnumtmp := big.NewInt(0)
 	if iptype == 4 {
 		if meta.ipv4indexbaseaddr > 0 {
 			ipnumtmp.Rsh(ipnum, 16)
@@ -201,7 +257,7 @@ func checkip(meta *ip2locationmeta, ip string) (iptype uint32, ipnum *big.Int, i
 }
 
 // read byte
-func readuint8(f *os.File, pos int64) (uint8, error) {
+func readuint8(f io.ReaderAt, pos int64) (uint8, error) {
 	var retval uint8
 	data := make([]byte, 1)
 	_, err := f.ReadAt(data, pos-1)
@@ -213,7 +269,7 @@ func readuint8(f *os.File, pos int64) (uint8, error) {
 }
 
 // read unsigned 32-bit integer
-func readuint32(f *os.File, pos uint32) (uint32, error) {
+func readuint32(f io.ReaderAt, pos uint32) (uint32, error) {
 	pos2 := int64(pos)
 	data := make([]byte, 4)
 	_, err := f.ReadAt(data, pos2-1)
@@ -224,7 +280,7 @@ func readuint32(f *os.File, pos uint32) (uint32, error) {
 }
 
 // read unsigned 128-bit integer
-func readuint128(f *os.File, pos uint32) (*big.Int, error) {
+func readuint128(f io.ReaderAt, pos uint32) (*big.Int, error) {
 	pos2 := int64(pos)
 	retval := big.NewInt(0)
 	data := make([]byte, 16)
@@ -241,8 +297,20 @@ func readuint128(f *os.File, pos uint32) (*big.Int, error) {
 	return retval, nil
 }
 
+// read IP number of iptype
+func readipnum(f io.ReaderAt, iptype uint32, pos uint32) (*big.Int, error) {
+	if iptype == 4 {
+		val, err := readuint32(f, pos)
+		if err != nil {
+			return nil, err
+		}
+		return big.NewInt(int64(val)), nil
+	}
+	return readuint128(f, pos)
+}
+
 // read string
-func readstr(f *os.File, pos uint32) (string, error) {
+func readstr(f io.ReaderAt, pos uint32) (string, error) {
 	pos2 := int64(pos)
 	var retval string
 	lenbyte := make([]byte, 1)
@@ -261,7 +329,7 @@ func readstr(f *os.File, pos uint32) (string, error) {
 }
 
 // read float
-func readfloat(f *os.File, pos uint32) (float32, error) {
+func readfloat(f io.ReaderAt, pos uint32) (float32, error) {
 	pos2 := int64(pos)
 	var retval float32
 	data := make([]byte, 4)
@@ -287,56 +355,33 @@ func NewDB(dbpath string) (*DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	var meta ip2locationmeta
-	meta.databasetype, err = readuint8(f, 1)
-	if err != nil {
-		return nil, ErrInvalidFile
-	}
-	meta.databasecolumn, err = readuint8(f, 2)
-	if err != nil {
-		return nil, ErrInvalidFile
-	}
-	meta.databaseyear, err = readuint8(f, 3)
+	db, err := NewDBFromReader(f)
 	if err != nil {
-		return nil, ErrInvalidFile
-	}
-	meta.databasemonth, err = readuint8(f, 4)
-	if err != nil {
-		return nil, ErrInvalidFile
-	}
-	meta.databaseday, err = readuint8(f, 5)
-	if err != nil {
-		return nil, ErrInvalidFile
-	}
-	meta.ipv4databasecount, err = readuint32(f, 6)
-	if err != nil {
-		return nil, ErrInvalidFile
-	}
-	meta.ipv4databaseaddr, err = readuint32(f, 10)
-	if err != nil {
-		return nil, ErrInvalidFile
+		f.Close()
+		return nil, err
 	}
-	meta.ipv6databasecount, err = readuint32(f, 14)
+	return db, nil
+}
+
+// NewDBFromReader initializes db reading the database from r, e.g. a memory buffer or mapped file;
+// Close closes r if it implements io.Closer
+func NewDBFromReader(f io.ReaderAt) (*DB, error) {
+	meta, err := readmeta(f)
 	if err != nil {
-		return nil, ErrInvalidFile
+		return nil, err
 	}
-	meta.ipv6databaseaddr, err = readuint32(f, 18)
-	if err != nil {
-		return nil, ErrInvalidFile
+
+	if !meta.isproduct(ProductIP2Location) {
+		return nil, ErrWrongProduct
 	}
-	meta.ipv4indexbaseaddr, err = readuint32(f, 22)
-	if err != nil {
-		return nil, ErrInvalidFile
+	dbt := meta.databasetype
+	if dbt == 0 || int(dbt) >= len(countryPosition) {
+		return nil, ErrNotSupported // a product or database type this package does not know
 	}
-	meta.ipv6indexbaseaddr, err = readuint32(f, 26)
-	if err != nil {
+	if meta.databasecolumn < dbcolumns(dbt) {
 		return nil, ErrInvalidFile
 	}
-	meta.ipv4columnsize = uint32(meta.databasecolumn << 2)              // 4 bytes each column
-	meta.ipv6columnsize = uint32(16 + ((meta.databasecolumn - 1) << 2)) // 4 bytes each column, except IPFrom column which is 16 bytes
-
-	dbt := meta.databasetype
-	db := &DB{f: f, meta: meta}
+	db := &DB{f: f, meta: meta, mode: dbmode(dbt)}
 
 	// since both IPv4 and IPv6 use 4 bytes for the below columns, can just do it once here
 	if countryPosition[dbt] != 0 {
@@ -415,21 +460,129 @@ func NewDB(dbpath string) (*DB, error) {
 		db.usagetypePositionOffset = uint32(usagetypePosition[dbt]-1) << 2
 		db.usagetypeEnabled = true
 	}
+	if addresstypePosition[dbt] != 0 {
+		db.addresstypePositionOffset = uint32(addresstypePosition[dbt]-1) << 2
+		db.addresstypeEnabled = true
+	}
+	if categoryPosition[dbt] != 0 {
+		db.categoryPositionOffset = uint32(categoryPosition[dbt]-1) << 2
+		db.categoryEnabled = true
+	}
+	if districtPosition[dbt] != 0 {
+		db.districtPositionOffset = uint32(districtPosition[dbt]-1) << 2
+		db.districtEnabled = true
+	}
+	if asnPosition[dbt] != 0 {
+		db.asnPositionOffset = uint32(asnPosition[dbt]-1) << 2
+		db.asnEnabled = true
+	}
+	if asPosition[dbt] != 0 {
+		db.asPositionOffset = uint32(asPosition[dbt]-1) << 2
+		db.asEnabled = true
+	}
 
 	return db, nil
 }
 
+// read header of the database
+func readmeta(f io.ReaderAt) (meta ip2locationmeta, err error) {
+	meta.databasetype, err = readuint8(f, 1)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.databasecolumn, err = readuint8(f, 2)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.databaseyear, err = readuint8(f, 3)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.databasemonth, err = readuint8(f, 4)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.databaseday, err = readuint8(f, 5)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv4databasecount, err = readuint32(f, 6)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv4databaseaddr, err = readuint32(f, 10)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv6databasecount, err = readuint32(f, 14)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv6databaseaddr, err = readuint32(f, 18)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv4indexbaseaddr, err = readuint32(f, 22)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv6indexbaseaddr, err = readuint32(f, 26)
+	if err != nil {
+		return meta, ErrInvalidFile
+	}
+	if meta.databasetype == 'P' && meta.databasecolumn == 'K' { // a zip archive
+		return meta, ErrInvalidFile
+	}
+	// bytes 30 to 35 are zero in files before 2021
+	if meta.productcode, err = readuint8(f, 30); err != nil {
+		return meta, ErrInvalidFile
+	}
+	if meta.producttype, err = readuint8(f, 31); err != nil {
+		return meta, ErrInvalidFile
+	}
+	if meta.databasesize, err = readuint32(f, 32); err != nil {
+		return meta, ErrInvalidFile
+	}
+	meta.ipv4columnsize = uint32(meta.databasecolumn << 2)              // 4 bytes each column
+	meta.ipv6columnsize = uint32(16 + ((meta.databasecolumn - 1) << 2)) // 4 bytes each column, except IPFrom column which is 16 bytes
+	return meta, nil
+}
+
+// whether the file is of product p; files before 2021 record no product
+func (meta *ip2locationmeta) isproduct(p Product) bool {
+	return meta.productcode == uint8(p) || meta.productcode == uint8(ProductUnknown) && meta.databaseyear < 21
+}
+
 // APIVersion returns api version
 func APIVersion() string { return version }
 
+// Metadata returns the header of the database file
+func (db *DB) Metadata() Metadata {
+	return Metadata{
+		Type:        db.meta.databasetype,
+		Columns:     db.meta.databasecolumn,
+		Date:        time.Date(2000+int(db.meta.databaseyear), time.Month(db.meta.databasemonth), int(db.meta.databaseday), 0, 0, 0, 0, time.UTC),
+		IPv4Count:   db.meta.ipv4databasecount,
+		IPv6Count:   db.meta.ipv6databasecount,
+		Product:     Product(db.meta.productcode),
+		ProductType: db.meta.producttype,
+		Size:        db.meta.databasesize,
+	}
+}
+
 // Close closes db
-func (db *DB) Close() error { return db.f.Close() }
+func (db *DB) Close() error {
+	if c, ok := db.f.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
 
 // Get return fields selected by `mod`
 func (db *DB) Get(ip string, mod uint32) (*Record, error) { return db.query(ip, mod) }
 
 // GetAll returns all fields
-func (db *DB) GetAll(ip string) (*Record, error) { return db.query(ip, ModeDB24) }
+func (db *DB) GetAll(ip string) (*Record, error) { return db.query(ip, ModeDB26) }
 
 // GetCountryShort returns country code
 func (db *DB) GetCountryShort(ip string) (*Record, error) { return db.query(ip, ModeCountryShort) }
@@ -499,6 +652,21 @@ func (db *DB) GetElevation(ip string) (*Record, error) { return db.query(ip, Mod
 // GetUsageType returns usage type
 func (db *DB) GetUsageType(ip string) (*Record, error) { return db.query(ip, ModeUsageType) }
 
+// GetAddressType returns address type
+func (db *DB) GetAddressType(ip string) (*Record, error) { return db.query(ip, ModeAddressType) }
+
+// GetCategory returns category
+func (db *DB) GetCategory(ip string) (*Record, error) { return db.query(ip, ModeCategory) }
+
+// GetDistrict returns district
+func (db *DB) GetDistrict(ip string) (*Record, error) { return db.query(ip, ModeDistrict) }
+
+// GetASN returns autonomous system number
+func (db *DB) GetASN(ip string) (*Record, error) { return db.query(ip, ModeASN) }
+
+// GetAS returns autonomous system name
+func (db *DB) GetAS(ip string) (*Record, error) { return db.query(ip, ModeAS) }
+
 // main query
 func (db *DB) query(ip string, mode uint32) (*Record, error) {
 	// check IP type and return IP number & index (if exists)
@@ -507,6 +675,18 @@ func (db *DB) query(ip string, mode uint32) (*Record, error) {
 		return nil, ErrInvalidAddress
 	}
 
+	if row, ok := db.search(iptype, ipno, ipindex); ok {
+		baseaddr, colsize := db.meta.ipv4databaseaddr, db.meta.ipv4columnsize
+		if iptype == 6 {
+			baseaddr, colsize = db.meta.ipv6databaseaddr, db.meta.ipv6columnsize
+		}
+		return db.readrecord(baseaddr+(row*colsize), iptype, mode), nil
+	}
+	return &Record{Mode: ModePopulated | mode&db.mode}, nil
+}
+
+// binary search of the row containing IP number
+func (db *DB) search(iptype uint32, ipno *big.Int, ipindex uint32) (row uint32, ok bool) {
 	var colsize uint32
 	var baseaddr uint32
 	var low uint32
@@ -514,7 +694,6 @@ func (db *DB) query(ip string, mode uint32) (*Record, error) {
 	var mid uint32
 	var rowoffset uint32
 	var rowoffset2 uint32
-	var x Record
 	ipfrom := big.NewInt(0)
 	ipto := big.NewInt(0)
 	maxip := big.NewInt(0)
@@ -538,7 +717,7 @@ func (db *DB) query(ip string, mode uint32) (*Record, error) {
 	}
 
 	if ipno.Cmp(maxip) >= 0 {
-		ipno = ipno.Sub(ipno, big.NewInt(1))
+		ipno = new(big.Int).Sub(ipno, big.NewInt(1))
 	}
 
 	for low <= high {
@@ -546,131 +725,204 @@ func (db *DB) query(ip string, mode uint32) (*Record, error) {
 		rowoffset = baseaddr + (mid * colsize)
 		rowoffset2 = rowoffset + colsize
 
-		if iptype == 4 {
-			val, _ := readuint32(db.f, rowoffset)
-			ipfrom = big.NewInt(int64(val))
-			val, _ = readuint32(db.f, rowoffset2)
-			ipto = big.NewInt(int64(val))
-		} else {
-			ipfrom, _ = readuint128(db.f, rowoffset)
-			ipto, _ = readuint128(db.f, rowoffset2)
+		ipfrom, _ = readipnum(db.f, iptype, rowoffset)
+		ipto, _ = readipnum(db.f, iptype, rowoffset2)
+		if ipfrom == nil || ipto == nil {
+			break
 		}
 
 		if ipno.Cmp(ipfrom) >= 0 && ipno.Cmp(ipto) < 0 {
-			if iptype == 6 {
-				rowoffset = rowoffset + 12 // coz below is assuming all columns are 4 bytes, so got 12 left to go to make 16 bytes total
-			}
-
-			if mode&ModeCountryShort == 1 && db.countryEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.countryPositionOffset)
-				x.CountryShort, _ = readstr(db.f, val)
+			return mid, true
+		} else {
+			if ipno.Cmp(ipfrom) < 0 {
+				high = mid - 1
+			} else {
+				low = mid + 1
 			}
+		}
+	}
+	return 0, false
+}
 
-			if mode&ModeCountryLong != 0 && db.countryEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.countryPositionOffset)
-				x.CountryLong, _ = readstr(db.f, val+3)
-			}
+// read fields selected by mode from the row at rowoffset
+func (db *DB) readrecord(rowoffset uint32, iptype uint32, mode uint32) *Record {
+	x := Record{Mode: ModePopulated | mode&db.mode}
+	if iptype == 6 {
+		rowoffset = rowoffset + 12 // coz below is assuming all columns are 4 bytes, so got 12 left to go to make 16 bytes total
+	}
 
-			if mode&ModeRegion != 0 && db.regionEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.regionPositionOffset)
-				x.Region, _ = readstr(db.f, val)
-			}
+	if mode&ModeCountryShort == 1 && db.countryEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.countryPositionOffset)
+		x.CountryShort, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeCity != 0 && db.cityEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.cityPositionOffset)
-				x.City, _ = readstr(db.f, val)
-			}
+	if mode&ModeCountryLong != 0 && db.countryEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.countryPositionOffset)
+		x.CountryLong, _ = readstr(db.f, val+3)
+	}
 
-			if mode&ModeISP != 0 && db.ispEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.ispPositionOffset)
-				x.ISP, _ = readstr(db.f, val)
-			}
+	if mode&ModeRegion != 0 && db.regionEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.regionPositionOffset)
+		x.Region, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeLatitude != 0 && db.latitudeEnabled {
-				x.Latitude, _ = readfloat(db.f, rowoffset+db.latitudePositionOffset)
-			}
+	if mode&ModeCity != 0 && db.cityEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.cityPositionOffset)
+		x.City, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeLongitude != 0 && db.longitudeEnabled {
-				x.Longitude, _ = readfloat(db.f, rowoffset+db.longitudePositionOffset)
-			}
+	if mode&ModeISP != 0 && db.ispEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.ispPositionOffset)
+		x.ISP, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeDomain != 0 && db.domainEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.domainPositionOffset)
-				x.Domain, _ = readstr(db.f, val)
-			}
+	if mode&ModeLatitude != 0 && db.latitudeEnabled {
+		x.Latitude, _ = readfloat(db.f, rowoffset+db.latitudePositionOffset)
+	}
 
-			if mode&ModeZipCode != 0 && db.zipcodeEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.zipcodePositionOffset)
-				x.ZipCode, _ = readstr(db.f, val)
-			}
+	if mode&ModeLongitude != 0 && db.longitudeEnabled {
+		x.Longitude, _ = readfloat(db.f, rowoffset+db.longitudePositionOffset)
+	}
 
-			if mode&ModeTimeZone != 0 && db.timezoneEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.timezonePositionOffset)
-				x.TimeZone, _ = readstr(db.f, val)
-			}
+	if mode&ModeDomain != 0 && db.domainEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.domainPositionOffset)
+		x.Domain, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeNetSpeed != 0 && db.netspeedEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.netspeedPositionOffset)
-				x.NetSpeed, _ = readstr(db.f, val)
-			}
+	if mode&ModeZipCode != 0 && db.zipcodeEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.zipcodePositionOffset)
+		x.ZipCode, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeIddCode != 0 && db.iddcodeEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.iddcodePositionOffset)
-				x.IddCode, _ = readstr(db.f, val)
-			}
+	if mode&ModeTimeZone != 0 && db.timezoneEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.timezonePositionOffset)
+		x.TimeZone, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeAreaCode != 0 && db.areacodeEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.areacodePositionOffset)
-				x.AreaCode, _ = readstr(db.f, val)
-			}
+	if mode&ModeNetSpeed != 0 && db.netspeedEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.netspeedPositionOffset)
+		x.NetSpeed, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeWeatherStationCode != 0 && db.weatherstationcodeEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.weatherstationcodePositionOffset)
-				x.WeatherStationCode, _ = readstr(db.f, val)
-			}
+	if mode&ModeIddCode != 0 && db.iddcodeEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.iddcodePositionOffset)
+		x.IddCode, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeWeatherStationName != 0 && db.weatherstationnameEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.weatherstationnamePositionOffset)
-				x.WeatherStationName, _ = readstr(db.f, val)
-			}
+	if mode&ModeAreaCode != 0 && db.areacodeEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.areacodePositionOffset)
+		x.AreaCode, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeMobileCountryCode != 0 && db.mccEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.mccPositionOffset)
-				x.MobileCountryCode, _ = readstr(db.f, val)
-			}
+	if mode&ModeWeatherStationCode != 0 && db.weatherstationcodeEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.weatherstationcodePositionOffset)
+		x.WeatherStationCode, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeMobileNetworkCode != 0 && db.mncEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.mncPositionOffset)
-				x.MobileNetworkCode, _ = readstr(db.f, val)
-			}
+	if mode&ModeWeatherStationName != 0 && db.weatherstationnameEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.weatherstationnamePositionOffset)
+		x.WeatherStationName, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeMobileBrand != 0 && db.mobilebrandEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.mobilebrandPositionOffset)
-				x.MobileBrand, _ = readstr(db.f, val)
-			}
+	if mode&ModeMobileCountryCode != 0 && db.mccEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.mccPositionOffset)
+		x.MobileCountryCode, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeElevation != 0 && db.elevationEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.elevationPositionOffset)
-				vals, _ := readstr(db.f, val)
-				f, _ := strconv.ParseFloat(vals, 32)
-				x.Elevation = float32(f)
-			}
+	if mode&ModeMobileNetworkCode != 0 && db.mncEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.mncPositionOffset)
+		x.MobileNetworkCode, _ = readstr(db.f, val)
+	}
 
-			if mode&ModeUsageType != 0 && db.usagetypeEnabled {
-				val, _ := readuint32(db.f, rowoffset+db.usagetypePositionOffset)
-				x.UsageType, _ = readstr(db.f, val)
-			}
+	if mode&ModeMobileBrand != 0 && db.mobilebrandEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.mobilebrandPositionOffset)
+		x.MobileBrand, _ = readstr(db.f, val)
+	}
 
-			return &x, nil
+	if mode&ModeElevation != 0 && db.elevationEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.elevationPositionOffset)
+		vals, _ := readstr(db.f, val)
+		e, ok, err := ParseElevation(vals)
+		if ok {
+			x.Elevation = e
 		} else {
-			if ipno.Cmp(ipfrom) < 0 {
-				high = mid - 1
-			} else {
-				low = mid + 1
-			}
+			x.Mode &^= ModeElevation // unknown for the address
+			x.elevationerr = err
 		}
 	}
-	return &x, nil
+
+	if mode&ModeUsageType != 0 && db.usagetypeEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.usagetypePositionOffset)
+		x.UsageType, _ = readstr(db.f, val)
+	}
+
+	if mode&ModeAddressType != 0 && db.addresstypeEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.addresstypePositionOffset)
+		x.AddressType, _ = readstr(db.f, val)
+	}
+
+	if mode&ModeCategory != 0 && db.categoryEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.categoryPositionOffset)
+		x.Category, _ = readstr(db.f, val)
+	}
+
+	if mode&ModeDistrict != 0 && db.districtEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.districtPositionOffset)
+		x.District, _ = readstr(db.f, val)
+	}
+
+	if mode&ModeASN != 0 && db.asnEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.asnPositionOffset)
+		x.ASN, _ = readstr(db.f, val)
+	}
+
+	if mode&ModeAS != 0 && db.asEnabled {
+		val, _ := readuint32(db.f, rowoffset+db.asPositionOffset)
+		x.AS, _ = readstr(db.f, val)
+	}
+
+	if db.normalize {
+		x.Normalize()
+	}
+	return &x
+}
+
+// Metadata describes a database file.
+//
+// Whether a file is a free LITE edition or a commercial one cannot be told from its header:
+// a LITE DB11 records the same type, product and columns as a commercial DB11, and no header
+// byte is documented to differ between them.
+type Metadata struct {
+	Type        uint8 // database type, 1 for DB1 through 26 for DB26
+	Columns     uint8 // number of columns, including IPFrom
+	Date        time.Time
+	IPv4Count   uint32 // rows in the IPv4 table
+	IPv6Count   uint32 // rows in the IPv6 table
+	Product     Product
+	ProductType uint8  // raw header byte after the product code; its values are not documented
+	Size        uint32 // size of the file in bytes, 0 if not recorded
+}
+
+// Product is the product family of a database file, recorded in files since 2021; the
+// codes are those checked by the IP2Location and IP2Proxy readers published by the vendor
+type Product uint8
+
+const (
+	ProductUnknown     Product = 0 // not recorded
+	ProductIP2Location Product = 1 // DB1 to DB26, read by DB
+	ProductIP2Proxy    Product = 2 // PX1 to PX11, read by ProxyDB
+)
+
+func (p Product) String() string {
+	switch p {
+	case ProductUnknown:
+		return "unknown"
+	case ProductIP2Location:
+		return "IP2Location"
+	case ProductIP2Proxy:
+		return "IP2Proxy"
+	}
+	return "product " + strconv.Itoa(int(p))
 }
 
 type ip2locationmeta struct {
@@ -685,6 +937,9 @@ type ip2locationmeta struct {
 	ipv6databaseaddr  uint32
 	ipv4indexbaseaddr uint32
 	ipv6indexbaseaddr uint32
+	productcode       uint8
+	producttype       uint8
+	databasesize      uint32
 	ipv4columnsize    uint32
 	ipv6columnsize    uint32
 }
@@ -0,0 +1,121 @@
+package ip2location_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+var (
+	paris = ip2location.Record{
+		CountryShort:       "FR",
+		CountryLong:        "France",
+		Region:             "Ile-de-France",
+		City:               "Paris",
+		ISP:                "Example ISP",
+		Latitude:           48.8566,
+		Longitude:          2.3522,
+		Domain:             "example.fr",
+		ZipCode:            "75001",
+		TimeZone:           "+01:00",
+		NetSpeed:           "DSL",
+		IddCode:            "33",
+		AreaCode:           "01",
+		WeatherStationCode: "FRXX0076",
+		WeatherStationName: "Paris",
+		MobileCountryCode:  "208",
+		MobileNetworkCode:  "01",
+		MobileBrand:        "Orange",
+		Elevation:          35,
+		UsageType:          "ISP/MOB",
+		AddressType:        "U",
+		Category:           "IAB19",
+		District:           "Paris",
+		ASN:                "3215",
+		AS:                 "Orange S.A.",
+	}
+	tokyo = ip2location.Record{
+		CountryShort:       "JP",
+		CountryLong:        "Japan",
+		Region:             "Tokyo",
+		City:               "Tokyo",
+		ISP:                "Example KK",
+		Latitude:           35.6895,
+		Longitude:          139.6917,
+		Domain:             "example.jp",
+		ZipCode:            "100-0001",
+		TimeZone:           "+09:00",
+		NetSpeed:           "T1",
+		IddCode:            "81",
+		AreaCode:           "03",
+		WeatherStationCode: "JAXX0085",
+		WeatherStationName: "Tokyo",
+		MobileCountryCode:  "440",
+		MobileNetworkCode:  "10",
+		MobileBrand:        "NTT DoCoMo",
+		Elevation:          40,
+		UsageType:          "DCH",
+		AddressType:        "U",
+		Category:           "IAB19-18",
+		District:           "Chiyoda",
+		ASN:                "2516",
+		AS:                 "KDDI Corporation",
+	}
+	table = ip2locationtest.Table{
+		"0.0.0.0/8":        paris,
+		"1.0.0.0/24":       tokyo,
+		"255.255.255.0/24": tokyo,
+		"2001:db8::/32":    paris,
+		"ffff::/16":        tokyo,
+	}
+)
+
+// the fields of want selected by mode, as looked up
+func lookedup(want ip2location.Record, mode uint32) (x ip2location.Record) {
+	want.Mode = ip2location.ModePopulated | mode
+	data, _ := json.Marshal(want)
+	json.Unmarshal(data, &x)
+	return x
+}
+
+func TestRoundTrip(t *testing.T) {
+	for dbt := uint8(1); dbt <= 26; dbt++ {
+		mode, err := ip2location.ParseMode(fmt.Sprintf("DB%d", dbt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		db, err := ip2locationtest.NewDB(dbt, table)
+		if err != nil {
+			t.Fatalf("DB%d: %v", dbt, err)
+		}
+		for _, tt := range []struct {
+			ip   string
+			want ip2location.Record
+		}{
+			{"0.0.0.0", paris},
+			{"0.255.255.255", paris},
+			{"1.0.0.1", tokyo},
+			{"255.255.255.255", tokyo},
+			{"2001:db8::1", paris},
+			{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", tokyo},
+			{"1.0.1.0", ip2location.Record{}}, // gaps
+			{"128.0.0.1", ip2location.Record{}},
+			{"2001:db9::", ip2location.Record{}},
+		} {
+			r, err := db.GetAll(tt.ip)
+			if err != nil {
+				t.Fatalf("DB%d %s: %v", dbt, tt.ip, err)
+			}
+			if want := lookedup(tt.want, mode); *r != want {
+				t.Errorf("DB%d %s:\n got %+v\nwant %+v", dbt, tt.ip, *r, want)
+			}
+		}
+		if got := db.Metadata().Type; got != dbt {
+			t.Errorf("DB%d: type %d", dbt, got)
+		}
+		db.Close()
+	}
+}
@@ -0,0 +1,66 @@
+// Package ip2locationtest builds small in-memory databases for tests,
+// so that code using ip2location can be tested without licensed BIN files.
+//
+//	db := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+//		"8.8.8.0/24":    {CountryShort: "US", CountryLong: "United States", Region: "California", City: "Mountain View"},
+//		"2001:db8::/32": {CountryShort: "DE", CountryLong: "Germany", Region: "Berlin", City: "Berlin"},
+//	})
+//	defer db.Close()
+package ip2locationtest
+
+import (
+	"bytes"
+	"net/netip"
+	"time"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+// Date is the date written to the header of built databases
+var Date = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Table maps non-overlapping CIDR prefixes, such as "8.8.8.0/24" or "2001:db8::/32", to records;
+// addresses outside of every prefix map to an empty Record
+type Table map[string]ip2location.Record
+
+// Build returns a database file of type dbtype (1 for DB1 through 26 for DB26) holding table;
+// fields of the records that dbtype does not carry are dropped
+func Build(dbtype uint8, table Table) ([]byte, error) {
+	w, err := ip2location.NewWriter(dbtype, Date)
+	if err != nil {
+		return nil, err
+	}
+	for cidr, rec := range table {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		rec := rec
+		if err = w.AddPrefix(prefix, &rec); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	if _, err = w.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// NewDB returns a database of type dbtype holding table
+func NewDB(dbtype uint8, table Table) (*ip2location.DB, error) {
+	data, err := Build(dbtype, table)
+	if err != nil {
+		return nil, err
+	}
+	return ip2location.NewDBFromReader(bytes.NewReader(data))
+}
+
+// MustNewDB is like NewDB but panics on error
+func MustNewDB(dbtype uint8, table Table) *ip2location.DB {
+	db, err := NewDB(dbtype, table)
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
@@ -0,0 +1,55 @@
+package ip2location
+
+import (
+	"math/big"
+	"net/netip"
+)
+
+// PrefixRange is the part of a prefix in a row of the database
+type PrefixRange struct {
+	Range
+	Fraction float64 // share of the prefix addresses in Range
+}
+
+// LookupPrefix returns every row overlapping prefix, clipped to it, with fields selected by `mode`.
+// The first row is found by the binary search of single lookups, then the table is scanned forward.
+func (db *DB) LookupPrefix(prefix netip.Prefix, mode uint32) ([]PrefixRange, error) {
+	if !prefix.IsValid() {
+		return nil, ErrInvalidAddress
+	}
+	if a := prefix.Addr(); a.Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(a.Unmap(), prefix.Bits()-96)
+	}
+	family, iptype := 6, uint32(6)
+	if prefix.Addr().Is4() {
+		family, iptype = 4, 4
+	}
+	from, to := prefixrange(prefix)
+	row, ok := db.search(iptype, from, checkindex(&db.meta, iptype, from))
+	if !ok {
+		return nil, nil
+	}
+
+	size := new(big.Float).SetInt(new(big.Int).Sub(to, from))
+	var result []PrefixRange
+	it := db.rangesfrom(family, mode, row)
+	for it.Next() {
+		r := it.Range()
+		if r.From.Cmp(to) >= 0 {
+			break
+		}
+		if r.From.Cmp(from) < 0 {
+			r.From = from
+		}
+		if r.To.Cmp(to) > 0 {
+			r.To = to
+		}
+		n := new(big.Float).SetInt(new(big.Int).Sub(r.To, r.From))
+		fraction, _ := n.Quo(n, size).Float64()
+		result = append(result, PrefixRange{Range: r, Fraction: fraction})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package ip2location_test
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+// the address as a number, as in Range
+func addrnum(s string) string {
+	return new(big.Int).SetBytes(netip.MustParseAddr(s).AsSlice()).String()
+}
+
+func TestLookupPrefix(t *testing.T) {
+	db := ip2locationtest.MustNewDB(1, ip2locationtest.Table{
+		"1.0.0.0/24":       tokyo,
+		"1.0.2.0/23":       paris,
+		"255.255.255.0/24": tokyo,
+		"2001:db8::/32":    paris,
+	})
+	defer db.Close()
+	type row struct {
+		from, to, country string
+		fraction          float64
+	}
+	for _, tt := range []struct {
+		prefix string
+		want   []row
+	}{
+		{"1.0.0.0/22", []row{ // several rows
+			{addrnum("1.0.0.0"), addrnum("1.0.1.0"), "JP", 0.25},
+			{addrnum("1.0.1.0"), addrnum("1.0.2.0"), "", 0.25},
+			{addrnum("1.0.2.0"), addrnum("1.0.4.0"), "FR", 0.5},
+		}},
+		{"1.0.0.128/25", []row{ // clipped at both edges
+			{addrnum("1.0.0.128"), addrnum("1.0.1.0"), "JP", 1},
+		}},
+		{"1.0.0.0/23", []row{ // clipped at the end
+			{addrnum("1.0.0.0"), addrnum("1.0.1.0"), "JP", 0.5},
+			{addrnum("1.0.1.0"), addrnum("1.0.2.0"), "", 0.5},
+		}},
+		{"1.0.3.0/24", []row{ // clipped at the start
+			{addrnum("1.0.3.0"), addrnum("1.0.4.0"), "FR", 1},
+		}},
+		{"0.0.0.0/0", []row{
+			{addrnum("0.0.0.0"), addrnum("1.0.0.0"), "", 1.0 / 256},
+			{addrnum("1.0.0.0"), addrnum("1.0.1.0"), "JP", 1.0 / (1 << 24)},
+			{addrnum("1.0.1.0"), addrnum("1.0.2.0"), "", 1.0 / (1 << 24)},
+			{addrnum("1.0.2.0"), addrnum("1.0.4.0"), "FR", 1.0 / (1 << 23)},
+			{addrnum("1.0.4.0"), addrnum("255.255.255.0"), "", float64(0xffffff00-0x01000400) / (1 << 32)},
+			{addrnum("255.255.255.0"), "4294967296", "JP", 1.0 / (1 << 24)},
+		}},
+		{"255.255.255.255/32", []row{
+			{addrnum("255.255.255.255"), "4294967296", "JP", 1},
+		}},
+		{"::ffff:1.0.2.0/119", []row{ // IPv4-mapped
+			{addrnum("1.0.2.0"), addrnum("1.0.4.0"), "FR", 1},
+		}},
+		{"2001:db8:8000::/33", []row{
+			{addrnum("2001:db8:8000::"), addrnum("2001:db9::"), "FR", 1},
+		}},
+	} {
+		got, err := db.LookupPrefix(netip.MustParsePrefix(tt.prefix), ip2location.ModeCountryShort)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.prefix, err)
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: %d rows, want %d: %+v", tt.prefix, len(got), len(tt.want), got)
+		}
+		family := 4
+		if p := netip.MustParsePrefix(tt.prefix); p.Addr().Is6() && !p.Addr().Is4In6() {
+			family = 6
+		}
+		for i, r := range got {
+			w := tt.want[i]
+			if r.Family != family || r.From.String() != w.from || r.To.String() != w.to || r.Record.CountryShort != w.country || r.Fraction != w.fraction {
+				t.Errorf("%s row %d: got IPv%d %s-%s %q %v, want IPv%d %s-%s %q %v", tt.prefix, i,
+					r.Family, r.From, r.To, r.Record.CountryShort, r.Fraction, family, w.from, w.to, w.country, w.fraction)
+			}
+		}
+	}
+	if _, err := db.LookupPrefix(netip.Prefix{}, ip2location.ModeCountryShort); err != ip2location.ErrInvalidAddress {
+		t.Errorf("invalid prefix: got %v, want ErrInvalidAddress", err)
+	}
+}
@@ -0,0 +1,250 @@
+package ip2location
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// version of the MarshalBinary encoding
+const binaryversion = 1
+
+// mode of the fields r holds; every field if r was not populated by a lookup
+func (r *Record) fieldmode() uint32 {
+	if r.Mode == 0 {
+		return ^uint32(0)
+	}
+	return r.Mode &^ ModePopulated
+}
+
+// value of a numeric field; false for string fields
+func numericvalue(r *Record, mode uint32) (float32, bool) {
+	switch mode {
+	case ModeLatitude:
+		return r.Latitude, true
+	case ModeLongitude:
+		return r.Longitude, true
+	case ModeElevation:
+		return r.Elevation, true
+	}
+	return 0, false
+}
+
+func setnumeric(r *Record, mode uint32, v float32) {
+	switch mode {
+	case ModeLatitude:
+		r.Latitude = v
+	case ModeLongitude:
+		r.Longitude = v
+	case ModeElevation:
+		r.Elevation = v
+	}
+}
+
+// MarshalJSON encodes the fields selected by r.Mode as an object keyed by IP2Location CSV column
+// names, e.g. {"country_code":"US","country_name":"United States"}, in CSV order; latitude,
+// longitude and elevation are numbers. A Record with a zero Mode encodes every field, and a
+// looked up Record without any of the requested fields encodes as {}.
+func (r Record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	mode := r.fieldmode()
+	for _, f := range fields {
+		if mode&f.mode == 0 {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('"')
+		buf.WriteString(f.name)
+		buf.WriteString(`":`)
+		if v, ok := numericvalue(&r, f.mode); ok {
+			buf.WriteString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+			continue
+		}
+		b, err := json.Marshal(f.get(&r))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes an object written by MarshalJSON, setting r.Mode to the fields present;
+// numeric fields may also be given as strings
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	x := Record{Mode: ModePopulated}
+	for name, raw := range values {
+		f := fieldbyname(name)
+		if f == nil {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		var s string
+		if json.Unmarshal(raw, &s) != nil {
+			s = string(raw) // a number
+		}
+		if err := f.set(&x, s); err != nil {
+			return fmt.Errorf("field %q: %w", name, err)
+		}
+		x.Mode |= f.mode
+	}
+	*r = x
+	return nil
+}
+
+// MarshalText encodes the fields selected by r.Mode as space-separated name=value pairs, e.g.
+// country_code=US country_name="United States"; values with spaces, quotes or '=' are quoted.
+// A looked up Record without any of the requested fields encodes as nothing.
+func (r Record) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	mode := r.fieldmode()
+	for _, f := range fields {
+		if mode&f.mode == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(f.name)
+		buf.WriteByte('=')
+		v := f.get(&r)
+		if n, ok := numericvalue(&r, f.mode); ok {
+			v = strconv.FormatFloat(float64(n), 'f', -1, 32)
+		}
+		if v == "" || strings.ContainsAny(v, " \t\r\n\"=\\") || !strconv.CanBackquote(v) {
+			v = strconv.Quote(v)
+		}
+		buf.WriteString(v)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalText decodes the name=value pairs written by MarshalText, setting r.Mode to the fields present
+func (r *Record) UnmarshalText(text []byte) error {
+	x := Record{Mode: ModePopulated}
+	s := strings.TrimSpace(string(text))
+	for s != "" {
+		i := strings.IndexByte(s, '=')
+		if i <= 0 {
+			return ErrInvalidRecord
+		}
+		name := s[:i]
+		f := fieldbyname(name)
+		if f == nil {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		s = s[i+1:]
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			quoted, err := strconv.QuotedPrefix(s)
+			if err != nil {
+				return fmt.Errorf("field %q: %w", name, err)
+			}
+			value, _ = strconv.Unquote(quoted)
+			s = s[len(quoted):]
+		} else if i := strings.IndexByte(s, ' '); i >= 0 {
+			value, s = s[:i], s[i:]
+		} else {
+			value, s = s, ""
+		}
+		if s != "" && s[0] != ' ' {
+			return ErrInvalidRecord
+		}
+		s = strings.TrimLeft(s, " ")
+		if err := f.set(&x, value); err != nil {
+			return fmt.Errorf("field %q: %w", name, err)
+		}
+		x.Mode |= f.mode
+	}
+	*r = x
+	return nil
+}
+
+// MarshalBinary encodes the fields selected by r.Mode compactly, for caches: a version byte,
+// the mode as a uvarint, then each field in CSV order, strings as a uvarint length and bytes,
+// numeric fields as 4 little-endian bytes. The mode keeps ModePopulated, or is zero for a
+// Record built by hand, so that decoding restores r.Mode.
+func (r Record) MarshalBinary() ([]byte, error) {
+	mode := r.fieldmode() & allfields()
+	buf := make([]byte, 0, 64)
+	buf = append(buf, binaryversion)
+	buf = appenduvarint(buf, uint64(r.Mode&(allfields()|ModePopulated)))
+	for _, f := range fields {
+		if mode&f.mode == 0 {
+			continue
+		}
+		if v, ok := numericvalue(&r, f.mode); ok {
+			var b [4]byte
+			binary.LittleEndian.PutUint32(b[:], math.Float32bits(v))
+			buf = append(buf, b[:]...)
+			continue
+		}
+		v := f.get(&r)
+		buf = appenduvarint(buf, uint64(len(v)))
+		buf = append(buf, v...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a Record written by MarshalBinary
+func (r *Record) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryversion {
+		return ErrInvalidRecord
+	}
+	data = data[1:]
+	mode, n := binary.Uvarint(data)
+	if n <= 0 || mode&^uint64(allfields()|ModePopulated) != 0 {
+		return ErrInvalidRecord
+	}
+	data = data[n:]
+	x := Record{Mode: uint32(mode)}
+	fieldmode := x.fieldmode()
+	for _, f := range fields {
+		if fieldmode&f.mode == 0 {
+			continue
+		}
+		if _, ok := numericvalue(&x, f.mode); ok {
+			if len(data) < 4 {
+				return ErrInvalidRecord
+			}
+			setnumeric(&x, f.mode, math.Float32frombits(binary.LittleEndian.Uint32(data)))
+			data = data[4:]
+			continue
+		}
+		size, n := binary.Uvarint(data)
+		if n <= 0 || size > uint64(len(data)-n) {
+			return ErrInvalidRecord
+		}
+		f.set(&x, string(data[n:n+int(size)]))
+		data = data[n+int(size):]
+	}
+	if len(data) != 0 {
+		return ErrInvalidRecord
+	}
+	*r = x
+	return nil
+}
+
+// modes of every field of Record
+func allfields() (mode uint32) {
+	for _, f := range fields {
+		mode |= f.mode
+	}
+	return
+}
+
+func appenduvarint(buf []byte, v uint64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	return append(buf, b[:binary.PutUvarint(b[:], v)]...)
+}
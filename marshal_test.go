@@ -0,0 +1,93 @@
+package ip2location_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestEmptyLookup(t *testing.T) {
+	db := ip2locationtest.MustNewDB(1, table)
+	defer db.Close()
+	for _, mode := range []uint32{ip2location.ModeRegion, ip2location.ModeElevation} {
+		r, err := db.Get("1.0.0.1", mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, _ := json.Marshal(r); string(data) != "{}" {
+			t.Errorf("mode %#x: JSON %s, want {}", mode, data)
+		}
+		if text, _ := r.MarshalText(); len(text) != 0 {
+			t.Errorf("mode %#x: text %q, want none", mode, text)
+		}
+		if _, ok, err := r.ElevationMeters(); ok || err != nil {
+			t.Errorf("mode %#x: elevation ok %v, err %v", mode, ok, err)
+		}
+	}
+	r, err := ip2location.NewOverlay(nil).Get("1.0.0.1", ip2location.ModeRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := json.Marshal(r); string(data) != "{}" {
+		t.Errorf("overlay: JSON %s, want {}", data)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	db := ip2locationtest.MustNewDB(26, table)
+	defer db.Close()
+	all, err := db.GetAll("0.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	some, err := db.Get("1.0.0.1", ip2location.ModeCountryShort|ip2location.ModeLatitude|ip2location.ModeElevation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	none, err := db.Get("1.0.1.0", ip2location.ModeCity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byhand := ip2location.Record{CountryShort: "US", Region: `Say "hi"`, Latitude: 37.4}
+	for _, r := range []*ip2location.Record{all, some, none, &byhand} {
+		var fromjson, fromtext, frombinary ip2location.Record
+		data, err := json.Marshal(r)
+		if err == nil {
+			err = json.Unmarshal(data, &fromjson)
+		}
+		if err != nil {
+			t.Fatalf("JSON %s: %v", data, err)
+		}
+		text, err := r.MarshalText()
+		if err == nil {
+			err = fromtext.UnmarshalText(text)
+		}
+		if err != nil {
+			t.Fatalf("text %q: %v", text, err)
+		}
+		bin, err := r.MarshalBinary()
+		if err == nil {
+			err = frombinary.UnmarshalBinary(bin)
+		}
+		if err != nil {
+			t.Fatalf("binary %x: %v", bin, err)
+		}
+		if frombinary != *r {
+			t.Errorf("binary: got %+v, want %+v", frombinary, *r)
+		}
+		if r.Mode == 0 {
+			continue // decoded with the Mode of every field
+		}
+		if fromjson != *r {
+			t.Errorf("JSON %s: got %+v, want %+v", data, fromjson, *r)
+		}
+		if fromtext != *r {
+			t.Errorf("text %q: got %+v, want %+v", text, fromtext, *r)
+		}
+	}
+	if err := new(ip2location.Record).UnmarshalBinary([]byte{1, 0x80}); err == nil {
+		t.Error("truncated binary: no error")
+	}
+}
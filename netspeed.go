@@ -0,0 +1,140 @@
+package ip2location
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidNetSpeed      = errors.New("Invalid net speed")
+	ErrInvalidElevation     = errors.New("Invalid elevation")
+	ErrInvalidMobileNetwork = errors.New("Invalid mobile network")
+)
+
+// NetSpeed is the connection type of an IP2Location database
+type NetSpeed uint8
+
+const (
+	NetSpeedUnknown NetSpeed = iota // "-" or empty
+	NetSpeedDial                    // DIAL: dial-up
+	NetSpeedDSL                     // DSL: broadband, cable, fiber, mobile
+	NetSpeedCompany                 // COMP: company, T1
+	NetSpeedT1                      // T1: leased line, in older databases
+)
+
+var netspeedcodes = [...]string{"-", "DIAL", "DSL", "COMP", "T1"}
+
+// ParseNetSpeed parses a net speed such as "DSL"
+func ParseNetSpeed(s string) (NetSpeed, error) {
+	if s == "" || s == "-" {
+		return NetSpeedUnknown, nil
+	}
+	for i, code := range netspeedcodes[1:] {
+		if strings.EqualFold(code, s) {
+			return NetSpeed(i + 1), nil
+		}
+	}
+	return NetSpeedUnknown, fmt.Errorf("%w %q", ErrInvalidNetSpeed, s)
+}
+
+func (n NetSpeed) String() string {
+	if int(n) < len(netspeedcodes) {
+		return netspeedcodes[n]
+	}
+	return "netspeed " + strconv.Itoa(int(n))
+}
+
+// Speed parses r.NetSpeed
+func (r *Record) Speed() (NetSpeed, error) { return ParseNetSpeed(r.NetSpeed) }
+
+// ParseElevation parses an elevation in meters; ok is false for "-" and empty values.
+// Values that are not numbers, or outside -1000 to 10000 meters, are an error.
+func ParseElevation(s string) (meters float32, ok bool, err error) {
+	if s == "" || s == "-" {
+		return 0, false, nil
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 32)
+	if err != nil || math.IsNaN(f) || f < -1000 || f > 10000 {
+		return 0, false, fmt.Errorf("%w %q", ErrInvalidElevation, s)
+	}
+	return float32(f), true, nil
+}
+
+// ElevationMeters returns r.Elevation; ok is false if the lookup found no elevation for the
+// address, or the database has none, and err wraps ErrInvalidElevation if the database value
+// for the address is malformed. A Record built by hand always reports ok.
+func (r *Record) ElevationMeters() (meters float32, ok bool, err error) {
+	if r.elevationerr != nil {
+		return 0, false, r.elevationerr
+	}
+	return r.Elevation, r.Mode == 0 || r.Mode&ModeElevation != 0, nil
+}
+
+// MobileNetwork identifies the mobile carrier of an address
+type MobileNetwork struct {
+	MCC   string // mobile country code, 3 digits
+	MNC   string // mobile network code, 2 or 3 digits; several codes are separated by '/'
+	Brand string
+}
+
+// PLMN returns the public land mobile network identifier, MCC followed by MNC, e.g. "310410";
+// the first network if MNC has several codes, and "" if n is not a mobile network
+func (n MobileNetwork) PLMN() string {
+	if n.MCC == "" {
+		return ""
+	}
+	mnc := n.MNC
+	if i := strings.IndexByte(mnc, '/'); i >= 0 {
+		mnc = mnc[:i]
+	}
+	return n.MCC + mnc
+}
+
+// PLMNs returns the PLMN identifiers of every network code of n
+func (n MobileNetwork) PLMNs() []string {
+	if n.MCC == "" {
+		return nil
+	}
+	var plmns []string
+	for _, mnc := range strings.Split(n.MNC, "/") {
+		plmns = append(plmns, n.MCC+mnc)
+	}
+	return plmns
+}
+
+// MobileNetwork returns the validated mobile fields of r, empty if r is not a mobile address
+// (fields of "-" or empty); codes that are not digits of the right length are an error
+func (r *Record) MobileNetwork() (MobileNetwork, error) {
+	n := MobileNetwork{MCC: r.MobileCountryCode, MNC: r.MobileNetworkCode, Brand: r.MobileBrand}
+	if placeholder(n.MCC) && placeholder(n.MNC) {
+		return MobileNetwork{}, nil
+	}
+	if placeholder(n.Brand) {
+		n.Brand = ""
+	}
+	if !digits(n.MCC, 3, 3) {
+		return MobileNetwork{}, fmt.Errorf("%w: mcc %q", ErrInvalidMobileNetwork, n.MCC)
+	}
+	for _, mnc := range strings.Split(n.MNC, "/") {
+		if !digits(mnc, 2, 3) {
+			return MobileNetwork{}, fmt.Errorf("%w: mnc %q", ErrInvalidMobileNetwork, n.MNC)
+		}
+	}
+	return n, nil
+}
+
+// whether s is min to max decimal digits
+func digits(s string, min, max int) bool {
+	if len(s) < min || len(s) > max {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
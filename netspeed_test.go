@@ -0,0 +1,108 @@
+package ip2location_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestParseNetSpeed(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want ip2location.NetSpeed
+		err  error
+	}{
+		{"DSL", ip2location.NetSpeedDSL, nil},
+		{"dial", ip2location.NetSpeedDial, nil},
+		{"COMP", ip2location.NetSpeedCompany, nil},
+		{"T1", ip2location.NetSpeedT1, nil},
+		{"-", ip2location.NetSpeedUnknown, nil},
+		{"", ip2location.NetSpeedUnknown, nil},
+		{"5G", ip2location.NetSpeedUnknown, ip2location.ErrInvalidNetSpeed},
+	} {
+		if got, err := ip2location.ParseNetSpeed(tt.in); got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("%q: got %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseElevation(t *testing.T) {
+	for _, tt := range []struct {
+		in     string
+		meters float32
+		ok     bool
+		err    error
+	}{
+		{"35", 35, true, nil},
+		{" -28 ", -28, true, nil},
+		{"8848.5", 8848.5, true, nil},
+		{"-", 0, false, nil},
+		{"", 0, false, nil},
+		{"NaN", 0, false, ip2location.ErrInvalidElevation},
+		{"20000", 0, false, ip2location.ErrInvalidElevation},
+		{"high", 0, false, ip2location.ErrInvalidElevation},
+	} {
+		meters, ok, err := ip2location.ParseElevation(tt.in)
+		if meters != tt.meters || ok != tt.ok || !errors.Is(err, tt.err) {
+			t.Errorf("%q: got %v, %v, %v, want %v, %v, %v", tt.in, meters, ok, err, tt.meters, tt.ok, tt.err)
+		}
+	}
+}
+
+func TestElevationMeters(t *testing.T) {
+	db := ip2locationtest.MustNewDB(26, table)
+	defer db.Close()
+	r, err := db.GetAll("1.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meters, ok, err := r.ElevationMeters(); meters != 40 || !ok || err != nil {
+		t.Errorf("looked up: got %v, %v, %v, want 40, true, <nil>", meters, ok, err)
+	}
+	if _, ok, err := (&ip2location.Record{}).ElevationMeters(); !ok || err != nil {
+		t.Errorf("by hand: got %v, %v, want true, <nil>", ok, err)
+	}
+}
+
+func TestMobileNetwork(t *testing.T) {
+	for _, tt := range []struct {
+		mcc, mnc, brand string
+		want            ip2location.MobileNetwork
+		plmns           []string
+		err             error
+	}{
+		{"310", "410", "AT&T", ip2location.MobileNetwork{MCC: "310", MNC: "410", Brand: "AT&T"}, []string{"310410"}, nil},
+		{"208", "01", "Orange", ip2location.MobileNetwork{MCC: "208", MNC: "01", Brand: "Orange"}, []string{"20801"}, nil},
+		{"404", "10/45", "Airtel", ip2location.MobileNetwork{MCC: "404", MNC: "10/45", Brand: "Airtel"}, []string{"40410", "40445"}, nil},
+		{"440", "10", "-", ip2location.MobileNetwork{MCC: "440", MNC: "10"}, []string{"44010"}, nil},
+		{"-", "-", "-", ip2location.MobileNetwork{}, nil, nil},
+		{"", "", "", ip2location.MobileNetwork{}, nil, nil},
+		{"31", "410", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+		{"3100", "410", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+		{"31A", "410", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+		{"310", "4", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+		{"310", "4100", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+		{"310", "10/", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+		{"310", "-", "", ip2location.MobileNetwork{}, nil, ip2location.ErrInvalidMobileNetwork},
+	} {
+		r := ip2location.Record{MobileCountryCode: tt.mcc, MobileNetworkCode: tt.mnc, MobileBrand: tt.brand}
+		got, err := r.MobileNetwork()
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("%q %q: got %+v, %v, want %+v, %v", tt.mcc, tt.mnc, got, err, tt.want, tt.err)
+			continue
+		}
+		if plmns := got.PLMNs(); !reflect.DeepEqual(plmns, tt.plmns) {
+			t.Errorf("%q %q: PLMNs %q, want %q", tt.mcc, tt.mnc, plmns, tt.plmns)
+		}
+		want := ""
+		if len(tt.plmns) > 0 {
+			want = tt.plmns[0]
+		}
+		if plmn := got.PLMN(); plmn != want {
+			t.Errorf("%q %q: PLMN %q, want %q", tt.mcc, tt.mnc, plmn, want)
+		}
+	}
+}
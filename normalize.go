@@ -0,0 +1,49 @@
+package ip2location
+
+import "strings"
+
+// placeholder reports whether s is a value IP2Location databases store for a missing field:
+// "-", or a notice that the field is unavailable in the data file
+func placeholder(s string) bool {
+	return s == "" || s == "-" || strings.HasPrefix(s, "This parameter is unavailable")
+}
+
+// SetNormalize sets whether lookups and range iteration of db empty the placeholder values of
+// lower-tier and LITE databases, see Record.Normalize; call it before using db
+func (db *DB) SetNormalize(normalize bool) { db.normalize = normalize }
+
+// Normalize empties the fields of r holding a placeholder such as "-" or
+// "This parameter is unavailable for selected data file. Please upgrade the data file."
+func (r *Record) Normalize() {
+	for _, f := range fields {
+		if v := f.get(r); v != "" && placeholder(v) {
+			f.set(r, "")
+		}
+	}
+}
+
+// Has reports whether every field selected by mode has a value, rather than being empty or a
+// placeholder. Latitude and longitude are missing when both are zero; elevation is missing if the
+// lookup found none, or is zero in a Record built by hand.
+func (r *Record) Has(mode uint32) bool {
+	for _, f := range fields {
+		if mode&f.mode == 0 {
+			continue
+		}
+		switch f.mode {
+		case ModeLatitude, ModeLongitude:
+			if r.Latitude == 0 && r.Longitude == 0 {
+				return false
+			}
+		case ModeElevation:
+			if m, ok, _ := r.ElevationMeters(); !ok || r.Mode == 0 && m == 0 {
+				return false
+			}
+		default:
+			if placeholder(f.get(r)) {
+				return false
+			}
+		}
+	}
+	return true
+}
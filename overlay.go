@@ -0,0 +1,162 @@
+package ip2location
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"sort"
+)
+
+// Overlay resolves addresses from user-defined prefixes, such as private and corporate networks,
+// before falling back to a database.
+//
+// The longest prefix containing an address provides the fields it defines; fields it does not
+// define come from the next longest prefix, and so on, and the remaining ones from the database.
+// Add, LoadCSV and LoadJSON must not be called concurrently with lookups.
+type Overlay struct {
+	db      *DB
+	entries []overlayentry // longest prefix first
+}
+
+type overlayentry struct {
+	prefix netip.Prefix
+	mode   uint32 // fields defined by rec
+	rec    Record
+}
+
+// NewOverlay returns an empty Overlay over db; db may be nil
+func NewOverlay(db *DB) *Overlay { return &Overlay{db: db} }
+
+// Add maps prefix to the fields of rec selected by mode
+func (o *Overlay) Add(prefix netip.Prefix, rec *Record, mode uint32) error {
+	if !prefix.IsValid() {
+		return ErrInvalidAddress
+	}
+	if a := prefix.Addr(); a.Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(a.Unmap(), prefix.Bits()-96)
+	}
+	e := overlayentry{prefix: prefix.Masked(), mode: mode}
+	copyfields(&e.rec, rec, mode)
+	i := sort.Search(len(o.entries), func(i int) bool { return o.entries[i].prefix.Bits() < e.prefix.Bits() })
+	o.entries = append(o.entries, overlayentry{})
+	copy(o.entries[i+1:], o.entries[i:])
+	o.entries[i] = e
+	return nil
+}
+
+// LoadCSV adds the lines of a CSV file with a header line naming its columns:
+// "prefix", and IP2Location CSV column names such as "country_code" or "city_name".
+// Empty values leave the field undefined.
+//
+//	prefix,country_code,country_name,city_name
+//	10.0.0.0/8,US,United States,Chicago
+func (o *Overlay) LoadCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+	for line := 2; ; line++ {
+		values, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entry := make(map[string]string, len(values))
+		for i, name := range header {
+			if values[i] != "" {
+				entry[name] = values[i]
+			}
+		}
+		if err = o.add(entry); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+// LoadJSON adds the entries of a JSON array of objects with a "prefix",
+// and fields named as IP2Location CSV columns. As with LoadCSV, empty strings
+// and nulls leave the field undefined.
+//
+//	[{"prefix": "10.0.0.0/8", "country_code": "US", "city_name": "Chicago", "latitude": 41.85}]
+func (o *Overlay) LoadJSON(r io.Reader) error {
+	var entries []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return err
+	}
+	for i, e := range entries {
+		entry := make(map[string]string, len(e))
+		for name, raw := range e {
+			var s string
+			if json.Unmarshal(raw, &s) != nil {
+				s = string(raw) // a number
+			}
+			if s != "" { // also for null
+				entry[name] = s
+			}
+		}
+		if err := o.add(entry); err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// add an entry of named values
+func (o *Overlay) add(entry map[string]string) error {
+	prefix, err := netip.ParsePrefix(entry["prefix"])
+	if err != nil {
+		return err
+	}
+	var rec Record
+	var mode uint32
+	for name, value := range entry {
+		if name == "prefix" {
+			continue
+		}
+		f := fieldbyname(name)
+		if f == nil {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		if err = f.set(&rec, value); err != nil {
+			return err
+		}
+		mode |= f.mode
+	}
+	return o.Add(prefix, &rec, mode)
+}
+
+// Get returns fields selected by `mode`
+func (o *Overlay) Get(ip string, mode uint32) (*Record, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	addr = addr.Unmap().WithZone("")
+	x := Record{Mode: ModePopulated}
+	rest := mode
+	for i := range o.entries {
+		e := &o.entries[i]
+		if rest&e.mode != 0 && e.prefix.Contains(addr) {
+			copyfields(&x, &e.rec, rest&e.mode)
+			rest &^= e.mode
+		}
+	}
+	if rest != 0 && o.db != nil {
+		r, err := o.db.query(ip, rest)
+		if err != nil {
+			return nil, err
+		}
+		copyfields(&x, r, rest)
+		x.Mode = r.Mode
+	}
+	x.Mode |= mode &^ rest
+	return &x, nil
+}
+
+// GetAll returns all fields
+func (o *Overlay) GetAll(ip string) (*Record, error) { return o.Get(ip, ModeDB26) }
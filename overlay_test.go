@@ -0,0 +1,85 @@
+package ip2location_test
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestOverlay(t *testing.T) {
+	db := ip2locationtest.MustNewDB(3, ip2locationtest.Table{
+		"10.0.0.0/8": {CountryShort: "JP", CountryLong: "Japan", Region: "Tokyo", City: "Tokyo"},
+		"8.8.8.0/24": {CountryShort: "US", CountryLong: "United States", Region: "California", City: "Mountain View"},
+	})
+	defer db.Close()
+	o := ip2location.NewOverlay(db)
+	// shorter prefixes after longer ones, to check the lookup order
+	err := o.LoadCSV(strings.NewReader("prefix,country_code,country_name,city_name\n" +
+		"10.1.2.0/24,,,Evanston\n" +
+		"10.1.0.0/16,,,Chicago\n" +
+		"10.0.0.0/8,US,United States,\n" +
+		"::ffff:192.168.0.0/112,ZZ,Private,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = o.LoadJSON(strings.NewReader(`[{"prefix": "10.1.2.128/25", "city_name": "", "region_name": null, "latitude": 41.85}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = o.Add(netip.MustParsePrefix("2001:db8::/32"), &ip2location.Record{CountryShort: "DE", Region: "Berlin"}, ip2location.ModeCountryShort); err != nil {
+		t.Fatal(err)
+	}
+
+	mode := ip2location.ModeCountryShort | ip2location.ModeRegion | ip2location.ModeCity | ip2location.ModeLatitude
+	for _, tt := range []struct {
+		ip                    string
+		country, region, city string
+		latitude              float32
+		withlatitude          bool
+	}{
+		{"10.1.2.200", "US", "Tokyo", "Evanston", 41.85, true}, // every prefix, region from the database
+		{"10.1.2.1", "US", "Tokyo", "Evanston", 0, false},
+		{"10.1.3.1", "US", "Tokyo", "Chicago", 0, false},
+		{"10.2.0.1", "US", "Tokyo", "Tokyo", 0, false}, // empty city of the CSV is undefined
+		{"192.168.1.1", "ZZ", "", "", 0, false},        // a 4-in-6 prefix
+		{"::ffff:192.168.1.1", "ZZ", "", "", 0, false},
+		{"8.8.8.8", "US", "California", "Mountain View", 0, false}, // the database only
+		{"2001:db8::1", "DE", "", "", 0, false},                    // only the fields of the mode of Add
+	} {
+		r, err := o.Get(tt.ip, mode)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.ip, err)
+		}
+		if r.CountryShort != tt.country || r.Region != tt.region || r.City != tt.city || r.Latitude != tt.latitude {
+			t.Errorf("%s: got %q %q %q %v, want %q %q %q %v", tt.ip, r.CountryShort, r.Region, r.City, r.Latitude,
+				tt.country, tt.region, tt.city, tt.latitude)
+		}
+		if got := r.Mode&ip2location.ModeLatitude != 0; got != tt.withlatitude {
+			t.Errorf("%s: latitude populated %v, want %v", tt.ip, got, tt.withlatitude)
+		}
+		if r.Mode&ip2location.ModeCity == 0 {
+			t.Errorf("%s: mode %#x without city", tt.ip, r.Mode)
+		}
+	}
+
+	without := ip2location.NewOverlay(nil)
+	if err = without.LoadCSV(strings.NewReader("prefix,city_name\n10.0.0.0/8,Chicago\n")); err != nil {
+		t.Fatal(err)
+	}
+	r, err := without.Get("10.0.0.1", ip2location.ModeCountryShort|ip2location.ModeCity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.City != "Chicago" || r.Mode != ip2location.ModePopulated|ip2location.ModeCity {
+		t.Errorf("without a database: got %q, mode %#x", r.City, r.Mode)
+	}
+	if _, err = without.Get("10.0.0", ip2location.ModeCity); err != ip2location.ErrInvalidAddress {
+		t.Errorf("invalid address: error %v", err)
+	}
+	if err = without.LoadJSON(strings.NewReader(`[{"prefix": "10.0.0.0/8", "town": "Chicago"}]`)); err == nil {
+		t.Error("unknown field: no error")
+	}
+}
@@ -0,0 +1,182 @@
+package ip2location
+
+import (
+	"io"
+	"os"
+)
+
+// ProxyRecord holds the fields of an IP2Proxy (PX) database
+type ProxyRecord struct {
+	CountryShort string
+	CountryLong  string
+	Region       string
+	City         string
+	ISP          string
+	ProxyType    string // VPN, TOR, DCH, PUB, WEB, SES, RES, ...; "-" if not a proxy
+	Domain       string
+	UsageType    string
+	ASN          string
+	AS           string
+	LastSeen     string // days since the proxy was last seen
+	Threat       string
+	Provider     string
+}
+
+// fields of IP2Proxy databases; country, region, city, isp, domain and usage type share the modes of Record
+const (
+	ModeProxyType uint32 = 1 << (20 + iota)
+	ModeASN
+	ModeAS
+	ModeLastSeen
+	ModeThreat
+	ModeProvider
+
+	ModePX1  = ModeCountryShort | ModeCountryLong //ip country
+	ModePX2  = ModePX1 | ModeProxyType            //ip proxytype country
+	ModePX3  = ModePX2 | ModeRegion | ModeCity    //ip proxytype country region city
+	ModePX4  = ModePX3 | ModeISP                  //ip proxytype country region city isp
+	ModePX5  = ModePX4 | ModeDomain               //ip proxytype country region city isp domain
+	ModePX6  = ModePX5 | ModeUsageType            //ip proxytype country region city isp domain usagetype
+	ModePX7  = ModePX6 | ModeASN | ModeAS         //ip proxytype country region city isp domain usagetype asn as
+	ModePX8  = ModePX7 | ModeLastSeen             //ip proxytype country region city isp domain usagetype asn as lastseen
+	ModePX9  = ModePX8 | ModeThreat               //ip proxytype country region city isp domain usagetype asn as lastseen threat
+	ModePX10 = ModePX9                            //ip proxytype country region city isp domain usagetype asn as lastseen threat (residential)
+	ModePX11 = ModePX10 | ModeProvider            //ip proxytype country region city isp domain usagetype asn as lastseen threat provider
+)
+
+var (
+	proxyCountryPosition   = [12]uint8{0, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
+	proxyRegionPosition    = [12]uint8{0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+	proxyCityPosition      = [12]uint8{0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5}
+	proxyISPPosition       = [12]uint8{0, 0, 0, 0, 6, 6, 6, 6, 6, 6, 6, 6}
+	proxyTypePosition      = [12]uint8{0, 0, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	proxyDomainPosition    = [12]uint8{0, 0, 0, 0, 0, 7, 7, 7, 7, 7, 7, 7}
+	proxyUsageTypePosition = [12]uint8{0, 0, 0, 0, 0, 0, 8, 8, 8, 8, 8, 8}
+	proxyASNPosition       = [12]uint8{0, 0, 0, 0, 0, 0, 0, 9, 9, 9, 9, 9}
+	proxyASPosition        = [12]uint8{0, 0, 0, 0, 0, 0, 0, 10, 10, 10, 10, 10}
+	proxyLastSeenPosition  = [12]uint8{0, 0, 0, 0, 0, 0, 0, 0, 11, 11, 11, 11}
+	proxyThreatPosition    = [12]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 12, 12}
+	proxyProviderPosition  = [12]uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 13}
+)
+
+var proxyfields = []struct {
+	mode     uint32
+	position *[12]uint8
+	value    func(*ProxyRecord) *string
+}{
+	{ModeCountryShort, &proxyCountryPosition, func(r *ProxyRecord) *string { return &r.CountryShort }},
+	{ModeCountryLong, &proxyCountryPosition, func(r *ProxyRecord) *string { return &r.CountryLong }},
+	{ModeRegion, &proxyRegionPosition, func(r *ProxyRecord) *string { return &r.Region }},
+	{ModeCity, &proxyCityPosition, func(r *ProxyRecord) *string { return &r.City }},
+	{ModeISP, &proxyISPPosition, func(r *ProxyRecord) *string { return &r.ISP }},
+	{ModeProxyType, &proxyTypePosition, func(r *ProxyRecord) *string { return &r.ProxyType }},
+	{ModeDomain, &proxyDomainPosition, func(r *ProxyRecord) *string { return &r.Domain }},
+	{ModeUsageType, &proxyUsageTypePosition, func(r *ProxyRecord) *string { return &r.UsageType }},
+	{ModeASN, &proxyASNPosition, func(r *ProxyRecord) *string { return &r.ASN }},
+	{ModeAS, &proxyASPosition, func(r *ProxyRecord) *string { return &r.AS }},
+	{ModeLastSeen, &proxyLastSeenPosition, func(r *ProxyRecord) *string { return &r.LastSeen }},
+	{ModeThreat, &proxyThreatPosition, func(r *ProxyRecord) *string { return &r.Threat }},
+	{ModeProvider, &proxyProviderPosition, func(r *ProxyRecord) *string { return &r.Provider }},
+}
+
+// ProxyDB reads IP2Proxy (PX1 to PX11) databases, which share the file layout of IP2Location
+// databases with different columns
+type ProxyDB struct {
+	db DB // header, index and search
+}
+
+// NewProxyDB initializes an IP2Proxy db with the database path
+func NewProxyDB(dbpath string) (*ProxyDB, error) {
+	f, err := os.Open(dbpath)
+	if err != nil {
+		return nil, err
+	}
+	db, err := NewProxyDBFromReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// NewProxyDBFromReader initializes an IP2Proxy db reading the database from r;
+// Close closes r if it implements io.Closer
+func NewProxyDBFromReader(f io.ReaderAt) (*ProxyDB, error) {
+	meta, err := readmeta(f)
+	if err != nil {
+		return nil, err
+	}
+	if !meta.isproduct(ProductIP2Proxy) {
+		return nil, ErrWrongProduct
+	}
+	if meta.databasetype == 0 || int(meta.databasetype) >= len(proxyCountryPosition) {
+		return nil, ErrNotSupported
+	}
+	if meta.databasecolumn < proxycolumns(meta.databasetype) {
+		return nil, ErrInvalidFile
+	}
+	return &ProxyDB{db: DB{f: f, meta: meta}}, nil
+}
+
+// number of columns of PX database type dbt, including IPFrom
+func proxycolumns(dbt uint8) (columns uint8) {
+	columns = 1
+	for _, f := range proxyfields {
+		if f.position[dbt] > columns {
+			columns = f.position[dbt]
+		}
+	}
+	return
+}
+
+// Metadata returns the header of the database file
+func (db *ProxyDB) Metadata() Metadata { return db.db.Metadata() }
+
+// Close closes db
+func (db *ProxyDB) Close() error { return db.db.Close() }
+
+// Get returns fields selected by `mode`
+func (db *ProxyDB) Get(ip string, mode uint32) (*ProxyRecord, error) {
+	iptype, ipno, ipindex := checkip(&db.db.meta, ip)
+	if iptype == 0 {
+		return nil, ErrInvalidAddress
+	}
+	row, ok := db.db.search(iptype, ipno, ipindex)
+	if !ok {
+		return &ProxyRecord{}, nil
+	}
+
+	rowoffset := db.db.meta.ipv4databaseaddr + row*db.db.meta.ipv4columnsize
+	if iptype == 6 {
+		rowoffset = db.db.meta.ipv6databaseaddr + row*db.db.meta.ipv6columnsize
+		rowoffset = rowoffset + 12 // coz below is assuming all columns are 4 bytes, so got 12 left to go to make 16 bytes total
+	}
+	var x ProxyRecord
+	dbt := db.db.meta.databasetype
+	for _, f := range proxyfields {
+		if mode&f.mode == 0 || f.position[dbt] == 0 {
+			continue
+		}
+		val, _ := readuint32(db.db.f, rowoffset+uint32(f.position[dbt]-1)<<2)
+		if f.mode == ModeCountryLong {
+			val += 3 // country name follows the 2-letter code
+		}
+		*f.value(&x), _ = readstr(db.db.f, val)
+	}
+	return &x, nil
+}
+
+// GetAll returns all fields
+func (db *ProxyDB) GetAll(ip string) (*ProxyRecord, error) { return db.Get(ip, ModePX11) }
+
+// IsProxy reports whether ip is a known proxy, VPN, data center or other anonymizer
+func (db *ProxyDB) IsProxy(ip string) (bool, error) {
+	x, err := db.Get(ip, ModeCountryShort|ModeProxyType)
+	if err != nil {
+		return false, err
+	}
+	if db.db.meta.databasetype == 1 { // PX1 only lists proxies, with a country of "-" for other addresses
+		return x.CountryShort != "" && x.CountryShort != "-", nil
+	}
+	return x.ProxyType != "" && x.ProxyType != "-", nil
+}
@@ -0,0 +1,183 @@
+package ip2location_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+// columns after IPFrom of PX databases, as documented by IP2Proxy
+var pxlayouts = map[uint8][]string{
+	1:  {"country"},
+	2:  {"proxytype", "country"},
+	4:  {"proxytype", "country", "region", "city", "isp"},
+	11: {"proxytype", "country", "region", "city", "isp", "domain", "usagetype", "asn", "as", "lastseen", "threat", "provider"},
+}
+
+func pxvalue(r *ip2location.ProxyRecord, column string) *string {
+	return map[string]*string{
+		"proxytype": &r.ProxyType,
+		"region":    &r.Region,
+		"city":      &r.City,
+		"isp":       &r.ISP,
+		"domain":    &r.Domain,
+		"usagetype": &r.UsageType,
+		"asn":       &r.ASN,
+		"as":        &r.AS,
+		"lastseen":  &r.LastSeen,
+		"threat":    &r.Threat,
+		"provider":  &r.Provider,
+	}[column]
+}
+
+type pxrow struct {
+	from string // IPv4 address, in ascending order from 0.0.0.0
+	rec  ip2location.ProxyRecord
+}
+
+// an IPv4-only PX database of type pxtype, without index
+func buildpx(pxtype uint8, rows []pxrow) []byte {
+	layout := pxlayouts[pxtype]
+	columns := uint32(len(layout) + 1)
+	rowsize := columns * 4
+	tableaddr := uint32(64)
+	strings := tableaddr + uint32(len(rows)+1)*rowsize // file offset of the strings
+
+	data := make([]byte, strings)
+	data[0] = pxtype
+	data[1] = uint8(columns)
+	data[2], data[3], data[4] = 24, 1, 1
+	binary.LittleEndian.PutUint32(data[5:], uint32(len(rows)+1))
+	binary.LittleEndian.PutUint32(data[9:], tableaddr+1)
+	data[29] = uint8(ip2location.ProductIP2Proxy)
+
+	str := func(s string) uint32 {
+		off := uint32(len(data))
+		data = append(append(data, uint8(len(s))), s...)
+		return off
+	}
+	var cells []uint32
+	for i, row := range rows {
+		cells = cells[:0]
+		for _, column := range layout {
+			if column == "country" {
+				off := str(row.rec.CountryShort)
+				for n := len(row.rec.CountryShort); n < 2; n++ {
+					data = append(data, 0)
+				}
+				str(row.rec.CountryLong)
+				cells = append(cells, off)
+			} else {
+				cells = append(cells, str(*pxvalue(&row.rec, column)))
+			}
+		}
+		pos := tableaddr + uint32(i)*rowsize
+		from := netip.MustParseAddr(row.from).As4()
+		binary.LittleEndian.PutUint32(data[pos:], binary.BigEndian.Uint32(from[:]))
+		for j, c := range cells {
+			binary.LittleEndian.PutUint32(data[pos+4+uint32(j)*4:], c)
+		}
+	}
+	last := tableaddr + uint32(len(rows))*rowsize // the row carrying the upper bound
+	binary.LittleEndian.PutUint32(data[last:], 0xffffffff)
+	binary.LittleEndian.PutUint32(data[31:], uint32(len(data)))
+	return data
+}
+
+var (
+	notproxy = ip2location.ProxyRecord{
+		CountryShort: "-", CountryLong: "-", Region: "-", City: "-", ISP: "-", ProxyType: "-", Domain: "-",
+		UsageType: "-", ASN: "-", AS: "-", LastSeen: "-", Threat: "-", Provider: "-",
+	}
+	vpn = ip2location.ProxyRecord{
+		CountryShort: "NL", CountryLong: "Netherlands", Region: "Noord-Holland", City: "Amsterdam",
+		ISP: "Example Hosting", ProxyType: "VPN", Domain: "example.nl", UsageType: "DCH", ASN: "64496",
+		AS: "Example AS", LastSeen: "3", Threat: "SPAM", Provider: "Example VPN",
+	}
+	pxrows = []pxrow{
+		{"0.0.0.0", notproxy},
+		{"1.0.0.0", vpn},
+		{"1.0.1.0", notproxy},
+	}
+)
+
+func TestProxyDB(t *testing.T) {
+	for pxtype, layout := range pxlayouts {
+		db, err := ip2location.NewProxyDBFromReader(bytes.NewReader(buildpx(pxtype, pxrows)))
+		if err != nil {
+			t.Fatalf("PX%d: %v", pxtype, err)
+		}
+		if got := db.Metadata().Product; got != ip2location.ProductIP2Proxy {
+			t.Errorf("PX%d: product %d", pxtype, got)
+		}
+		for _, tt := range []struct {
+			ip    string
+			rec   ip2location.ProxyRecord
+			proxy bool
+		}{
+			{"1.0.0.1", vpn, true},
+			{"1.0.1.1", notproxy, false},
+			{"0.0.0.1", notproxy, false},
+			{"255.255.255.255", notproxy, false},
+		} {
+			// only the columns of the layout are read
+			var want ip2location.ProxyRecord
+			for _, column := range layout {
+				if column == "country" {
+					want.CountryShort, want.CountryLong = tt.rec.CountryShort, tt.rec.CountryLong
+				} else {
+					*pxvalue(&want, column) = *pxvalue(&tt.rec, column)
+				}
+			}
+			got, err := db.GetAll(tt.ip)
+			if err != nil {
+				t.Fatalf("PX%d %s: %v", pxtype, tt.ip, err)
+			}
+			if *got != want {
+				t.Errorf("PX%d %s:\n got %+v\nwant %+v", pxtype, tt.ip, *got, want)
+			}
+			if proxy, err := db.IsProxy(tt.ip); proxy != tt.proxy || err != nil {
+				t.Errorf("PX%d %s: IsProxy %v, %v, want %v", pxtype, tt.ip, proxy, err, tt.proxy)
+			}
+		}
+		db.Close()
+	}
+}
+
+func TestWrongProduct(t *testing.T) {
+	dir := t.TempDir()
+	px := filepath.Join(dir, "PX2.BIN")
+	if err := os.WriteFile(px, buildpx(2, pxrows), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ip2locationtest.Build(3, table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db3 := filepath.Join(dir, "DB3.BIN")
+	if err = os.WriteFile(db3, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ip2location.NewDB(px); err != ip2location.ErrWrongProduct {
+		t.Errorf("NewDB of a PX file: got %v, want ErrWrongProduct", err)
+	}
+	if _, err = ip2location.NewProxyDB(db3); err != ip2location.ErrWrongProduct {
+		t.Errorf("NewProxyDB of a DB file: got %v, want ErrWrongProduct", err)
+	}
+	if db, err := ip2location.NewProxyDB(px); err != nil {
+		t.Errorf("NewProxyDB of a PX file: %v", err)
+	} else {
+		db.Close()
+	}
+	if db, err := ip2location.NewDB(db3); err != nil {
+		t.Errorf("NewDB of a DB file: %v", err)
+	} else {
+		db.Close()
+	}
+}
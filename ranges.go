@@ -0,0 +1,110 @@
+package ip2location
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrInvalidFamily is returned when an IP family other than 4 or 6 is requested
+var ErrInvalidFamily = errors.New("Invalid IP family")
+
+// Range is a row of the database: every address in [From, To) maps to Record
+type Range struct {
+	Family int // 4 or 6
+	From   *big.Int
+	To     *big.Int
+	Record *Record
+}
+
+// RangeIterator walks the rows of an IPv4 or IPv6 table in ascending order.
+//
+//	it := db.Ranges(4, ip2location.ModeCountryShort)
+//	for it.Next() {
+//		r := it.Range()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type RangeIterator struct {
+	db       *DB
+	family   int
+	mode     uint32
+	iptype   uint32
+	baseaddr uint32
+	colsize  uint32
+	count    uint32
+	maxip    *big.Int
+	row      uint32
+	next     *big.Int // IPFrom of row, i.e. the upper bound of the previous one
+	cur      Range
+	err      error
+}
+
+// Ranges returns an iterator over every row of the IPv4 (family 4) or IPv6 (family 6) table,
+// with fields selected by `mode`
+func (db *DB) Ranges(family int, mode uint32) *RangeIterator { return db.rangesfrom(family, mode, 0) }
+
+// iterator starting at row
+func (db *DB) rangesfrom(family int, mode uint32, row uint32) *RangeIterator {
+	it := &RangeIterator{db: db, family: family, mode: mode, row: row}
+	switch family {
+	case 4:
+		it.iptype = 4
+		it.baseaddr = db.meta.ipv4databaseaddr
+		it.colsize = db.meta.ipv4columnsize
+		it.count = db.meta.ipv4databasecount
+		it.maxip = maxIPV4Range
+	case 6:
+		it.iptype = 6
+		it.baseaddr = db.meta.ipv6databaseaddr
+		it.colsize = db.meta.ipv6columnsize
+		it.count = db.meta.ipv6databasecount
+		it.maxip = maxIPV6Range
+	default:
+		it.err = ErrInvalidFamily
+	}
+	return it
+}
+
+// Next advances to the next row; it returns false at the end of the table or on error
+func (it *RangeIterator) Next() bool {
+	if it.err != nil || it.row >= it.count {
+		return false
+	}
+	rowoffset := it.baseaddr + it.row*it.colsize
+	from := it.next
+	if from == nil {
+		if from, it.err = readipnum(it.db.f, it.iptype, rowoffset); it.err != nil {
+			return false
+		}
+	}
+	// the last row only carries the upper bound of the table
+	if from.Cmp(it.maxip) >= 0 {
+		it.row = it.count
+		return false
+	}
+	to, err := readipnum(it.db.f, it.iptype, rowoffset+it.colsize)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if to.Cmp(from) <= 0 {
+		it.err = ErrInvalidFile
+		return false
+	}
+	it.next = to
+	if to.Cmp(it.maxip) == 0 {
+		// lookups of the highest address fall into the last row, see query
+		to = new(big.Int).Add(to, big.NewInt(1))
+	}
+	it.cur = Range{Family: it.family, From: from, To: to, Record: it.db.readrecord(rowoffset, it.iptype, it.mode)}
+	it.row++
+	return true
+}
+
+// Range returns the current row
+func (it *RangeIterator) Range() Range { return it.cur }
+
+// Err returns the first error encountered during iteration
+func (it *RangeIterator) Err() error { return it.err }
@@ -0,0 +1,121 @@
+package ip2location
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+var ErrNoDatabase = errors.New("No database")
+
+// Endpoint is a candidate of a Selector, such as a mirror or a server
+type Endpoint struct {
+	Name      string
+	Latitude  float64 // both zero if the endpoint has no location
+	Longitude float64
+	Countries []string // ISO 3166-1 alpha-2 codes of clients preferring the endpoint, e.g. "DE"
+	Regions   []string // ISO 3166-2 codes of clients preferring the endpoint, e.g. "US-CA"
+}
+
+// Affinity is how closely an endpoint matches the country and region of a client
+type Affinity int
+
+const (
+	AffinityNone    Affinity = iota
+	AffinityCountry          // the client is in one of Endpoint.Countries
+	AffinityRegion           // the client is in one of Endpoint.Regions
+)
+
+// Fallback selects how a Selector ranks endpoints for clients without coordinates
+type Fallback int
+
+const (
+	FallbackAffinity Fallback = iota // by affinity only, then in the order of Selector.Endpoints
+	FallbackLocation                 // from Selector.Latitude and Selector.Longitude
+	FallbackError                    // fail with ErrNoCoordinates
+)
+
+// RankedEndpoint is an endpoint ranked for a client
+type RankedEndpoint struct {
+	Endpoint
+	Affinity Affinity
+	Distance float64 // great-circle distance in km from the client, negative if unknown
+}
+
+// Selector ranks endpoints by their distance from the location of a client IP address;
+// it is created with NewSelector, since a Selector literal has no database
+type Selector struct {
+	Endpoints []Endpoint
+	Fallback  Fallback
+	Latitude  float64 // client location of FallbackLocation
+	Longitude float64
+
+	db *DB
+}
+
+// NewSelector returns a Selector of endpoints, locating clients with db
+func NewSelector(db *DB, endpoints []Endpoint) *Selector {
+	return &Selector{Endpoints: endpoints, db: db}
+}
+
+// Select returns the endpoints ranked for the client ip: by affinity, region before country
+// before none, then by distance, endpoints without a location last, then in the order of
+// s.Endpoints. A client without coordinates is ranked as set by s.Fallback.
+// Select returns ErrNoDatabase if s was not created by NewSelector with a database.
+func (s *Selector) Select(ip string) ([]RankedEndpoint, error) {
+	if s.db == nil {
+		return nil, ErrNoDatabase
+	}
+	r, err := s.db.query(ip, ModeCountryShort|ModeRegion|ModeLatitude|ModeLongitude)
+	if err != nil {
+		return nil, err
+	}
+	lat, lon, located := r.Coordinates()
+	if !located {
+		switch s.Fallback {
+		case FallbackLocation:
+			lat, lon, located = s.Latitude, s.Longitude, validcoords(s.Latitude, s.Longitude)
+		case FallbackError:
+			return nil, ErrNoCoordinates
+		}
+	}
+	subdivision, _ := r.Subdivision()
+
+	ranked := make([]RankedEndpoint, len(s.Endpoints))
+	for i, e := range s.Endpoints {
+		ranked[i] = RankedEndpoint{Endpoint: e, Affinity: affinity(&e, r.CountryShort, subdivision), Distance: -1}
+		if located && (e.Latitude != 0 || e.Longitude != 0) && validcoords(e.Latitude, e.Longitude) {
+			ranked[i].Distance = haversine(lat, lon, e.Latitude, e.Longitude)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := &ranked[i], &ranked[j]
+		if a.Affinity != b.Affinity {
+			return a.Affinity > b.Affinity
+		}
+		if (a.Distance < 0) != (b.Distance < 0) {
+			return b.Distance < 0
+		}
+		return a.Distance < b.Distance
+	})
+	return ranked, nil
+}
+
+// affinity of e for a client of country and subdivision
+func affinity(e *Endpoint, country, subdivision string) Affinity {
+	if subdivision != "" {
+		for _, code := range e.Regions {
+			if strings.EqualFold(code, subdivision) {
+				return AffinityRegion
+			}
+		}
+	}
+	if !placeholder(country) {
+		for _, code := range e.Countries {
+			if strings.EqualFold(code, country) {
+				return AffinityCountry
+			}
+		}
+	}
+	return AffinityNone
+}
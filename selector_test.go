@@ -0,0 +1,91 @@
+package ip2location_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+	"github.com/zyxar/ip2location-go/ip2locationtest"
+)
+
+func TestSelector(t *testing.T) {
+	db := ip2locationtest.MustNewDB(5, ip2locationtest.Table{
+		"1.0.0.0/24": {CountryShort: "US", CountryLong: "United States", Region: "California", City: "Los Angeles", Latitude: 34.0522, Longitude: -118.2437},
+		"1.0.1.0/24": {CountryShort: "US", CountryLong: "United States", Region: "California", City: "-"}, // no coordinates
+		"1.0.2.0/24": {CountryShort: "DE", CountryLong: "Germany", Region: "Berlin", City: "Berlin", Latitude: 52.52, Longitude: 13.405},
+	})
+	defer db.Close()
+	endpoints := []ip2location.Endpoint{
+		{Name: "anycast"}, // no location
+		{Name: "asia", Latitude: 35.6895, Longitude: 139.6917},
+		{Name: "eu", Latitude: 50.1109, Longitude: 8.6821, Countries: []string{"DE", "AT", "CH"}},
+		{Name: "us-east", Latitude: 40.7128, Longitude: -74.006, Countries: []string{"US", "CA"}},
+		{Name: "us-west", Latitude: 37.7749, Longitude: -122.4194, Regions: []string{"US-CA", "US-OR"}},
+	}
+	for _, tt := range []struct {
+		name     string
+		ip       string
+		fallback ip2location.Fallback
+		want     string // names in rank order
+		err      error
+	}{
+		{"region, country, then distance", "1.0.0.1", ip2location.FallbackAffinity, "us-west us-east asia eu anycast", nil},
+		{"country, then distance", "1.0.2.1", ip2location.FallbackAffinity, "eu us-east asia us-west anycast", nil},
+		{"no affinity", "1.0.4.1", ip2location.FallbackAffinity, "anycast asia eu us-east us-west", nil},
+		{"affinity fallback", "1.0.1.1", ip2location.FallbackAffinity, "us-west us-east anycast asia eu", nil},
+		{"location fallback", "1.0.1.1", ip2location.FallbackLocation, "us-west us-east eu asia anycast", nil},
+		{"error fallback", "1.0.1.1", ip2location.FallbackError, "", ip2location.ErrNoCoordinates},
+		{"invalid address", "1.0.0", ip2location.FallbackAffinity, "", ip2location.ErrInvalidAddress},
+	} {
+		s := ip2location.NewSelector(db, endpoints)
+		s.Fallback = tt.fallback
+		s.Latitude, s.Longitude = 52.52, 13.405 // Berlin
+		ranked, err := s.Select(tt.ip)
+		if err != tt.err {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		var names []string
+		for _, e := range ranked {
+			names = append(names, e.Name)
+		}
+		if got := strings.Join(names, " "); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSelectorRanks(t *testing.T) {
+	db := ip2locationtest.MustNewDB(5, ip2locationtest.Table{
+		"1.0.0.0/24": {CountryShort: "US", CountryLong: "United States", Region: "California", City: "Los Angeles", Latitude: 34.0522, Longitude: -118.2437},
+	})
+	defer db.Close()
+	ranked, err := ip2location.NewSelector(db, []ip2location.Endpoint{
+		{Name: "us-west", Latitude: 37.7749, Longitude: -122.4194, Regions: []string{"us-ca"}},
+		{Name: "us-east", Latitude: 40.7128, Longitude: -74.006, Countries: []string{"us"}},
+		{Name: "anycast"},
+	}).Select("1.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []struct {
+		affinity ip2location.Affinity
+		distance float64
+	}{
+		{ip2location.AffinityRegion, 559},
+		{ip2location.AffinityCountry, 3936},
+		{ip2location.AffinityNone, -1},
+	} {
+		if r := ranked[i]; r.Affinity != want.affinity || math.Abs(r.Distance-want.distance) > 1 {
+			t.Errorf("%s: got affinity %d, distance %v, want %d, %v", r.Name, r.Affinity, r.Distance, want.affinity, want.distance)
+		}
+	}
+}
+
+func TestSelectorWithoutDB(t *testing.T) {
+	s := &ip2location.Selector{Endpoints: []ip2location.Endpoint{{Name: "anycast"}}}
+	if _, err := s.Select("1.0.0.1"); err != ip2location.ErrNoDatabase {
+		t.Errorf("got %v, want ErrNoDatabase", err)
+	}
+}
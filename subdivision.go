@@ -0,0 +1,113 @@
+package ip2location
+
+import (
+	"net/netip"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:generate go run -C gen . -o ../subdivisiondata.go subdivisions
+
+var (
+	subdivisiononce   sync.Once
+	subdivisionbyname map[string]string // country and folded name to code
+	subdivisioncodes  map[string]bool
+)
+
+// fold a region name for comparison: lower case letters and digits, without diacritics,
+// so that "Île-de-France" and "Ile de France" are equal
+func foldname(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		switch {
+		case c >= 0xC0 && c < 0xC0+rune(len(foldlatin)):
+			b.WriteString(foldlatin[c-0xC0])
+		case c >= 0x1E00 && c < 0x1E00+rune(len(foldlatinadditional)):
+			b.WriteString(foldlatinadditional[c-0x1E00])
+		case unicode.IsLetter(c) || unicode.IsDigit(c):
+			b.WriteRune(unicode.ToLower(c))
+		}
+	}
+	return b.String()
+}
+
+func loadsubdivisions() {
+	subdivisionbyname = make(map[string]string, len(subdivisiontable))
+	subdivisioncodes = make(map[string]bool, len(subdivisiontable))
+	for _, s := range subdivisiontable {
+		key := s.code[:2] + foldname(s.name)
+		if _, ok := subdivisionbyname[key]; !ok { // top-level subdivisions come first
+			subdivisionbyname[key] = s.code
+		}
+		subdivisioncodes[s.code] = true
+	}
+}
+
+// LookupSubdivision returns the ISO 3166-2 code, such as "US-CA", of a region of a country,
+// such as "US" and "California"; names are compared ignoring case, diacritics and punctuation,
+// and the shorter names used by IP2Location for China, such as "Beijing", are known too
+func LookupSubdivision(country, region string) (string, bool) {
+	if len(country) != 2 || placeholder(region) {
+		return "", false
+	}
+	subdivisiononce.Do(loadsubdivisions)
+	code, ok := subdivisionbyname[strings.ToUpper(country)+foldname(region)]
+	return code, ok
+}
+
+// Subdivision returns the ISO 3166-2 code of r.CountryShort and r.Region, see LookupSubdivision
+func (r *Record) Subdivision() (string, bool) { return LookupSubdivision(r.CountryShort, r.Region) }
+
+// SubdivisionRanges returns the CIDR prefixes, IPv4 first, of every address in the subdivision of
+// an ISO 3166-2 code such as "US-CA". As with FindRanges, the first call scans the database to
+// index it, and later calls are cheap.
+func (db *DB) SubdivisionRanges(code string) ([]netip.Prefix, error) {
+	if db.mode&ModeRegion == 0 {
+		return nil, ErrNotSupported
+	}
+	code = strings.ToUpper(code)
+	subdivisiononce.Do(loadsubdivisions)
+	if !subdivisioncodes[code] {
+		return nil, nil
+	}
+	db.findmu.Lock()
+	defer db.findmu.Unlock()
+	if db.subdivisionindex == nil {
+		index, err := db.buildsubdivisionindex()
+		if err != nil {
+			return nil, err
+		}
+		db.subdivisionindex = index
+	}
+	var prefixes []netip.Prefix
+	for _, r := range db.subdivisionindex[code] {
+		prefixes = append(prefixes, rangeprefixes(r.Family, r.From, r.To)...)
+	}
+	return prefixes, nil
+}
+
+// merged ranges of every subdivision
+func (db *DB) buildsubdivisionindex() (map[string][]Range, error) {
+	index := make(map[string][]Range)
+	for _, family := range []int{4, 6} {
+		it := db.Ranges(family, ModeCountryShort|ModeRegion)
+		for it.Next() {
+			r := it.Range()
+			code, ok := r.Record.Subdivision()
+			if !ok {
+				continue
+			}
+			ranges := index[code]
+			if n := len(ranges); n > 0 && ranges[n-1].Family == family && ranges[n-1].To.Cmp(r.From) == 0 {
+				ranges[n-1].To = r.To
+				continue
+			}
+			index[code] = append(ranges, Range{Family: family, From: r.From, To: r.To})
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return index, nil
+}
@@ -0,0 +1,26 @@
+package ip2location_test
+
+import (
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+func TestSubdivision(t *testing.T) {
+	for _, tt := range []struct{ country, region, want string }{
+		{"US", "California", "US-CA"},
+		{"FR", "Île-de-France", "FR-IDF"},
+		{"RO", "Timis", "RO-TM"},
+		{"RO", "Bucuresti", "RO-B"},
+		{"VN", "Ha Noi", "VN-HN"},
+		{"VN", "Ho Chi Minh", "VN-SG"},
+		{"CN", "Beijing", "CN-BJ"},
+		{"CN", "Guangdong Sheng", "CN-GD"},
+		{"US", "-", ""},
+	} {
+		got, _ := ip2location.LookupSubdivision(tt.country, tt.region)
+		if got != tt.want {
+			t.Errorf("%s %q: got %q, want %q", tt.country, tt.region, got, tt.want)
+		}
+	}
+}
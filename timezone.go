@@ -0,0 +1,163 @@
+package ip2location
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:generate go run -C gen . -o ../tzzones.go timezones
+
+var ErrInvalidTimeZone = errors.New("Invalid time zone")
+
+// an IANA time zone of a country
+type tzzone struct {
+	country   string
+	latitude  float64
+	longitude float64
+	name      string
+}
+
+var tzcache sync.Map // zone name to *time.Location, nil if not loadable
+
+// Location returns the time zone of r: the IANA zone of r.CountryShort closest to r's coordinates
+// whose standard or daylight saving offset agrees with r.TimeZone, such as "+08:00". Without
+// coordinates, a zone is chosen only if all matches in the country keep the same offsets.
+// Otherwise, Location returns a fixed zone of the r.TimeZone offset, named e.g. "UTC+08:00".
+// IANA zones are loaded with time.LoadLocation, so they require a zoneinfo database on the
+// system, or importing time/tzdata.
+func (r *Record) Location() (*time.Location, error) {
+	offset, hasoffset, err := parseoffset(r.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	if loc := r.ianazone(offset, hasoffset); loc != nil {
+		return loc, nil
+	}
+	if !hasoffset {
+		return nil, fmt.Errorf("%w %q", ErrInvalidTimeZone, r.TimeZone)
+	}
+	name := "UTC" + r.TimeZone
+	if r.TimeZone[0] != '+' && r.TimeZone[0] != '-' {
+		name = r.TimeZone
+	}
+	return time.FixedZone(name, offset), nil
+}
+
+// LocalTime returns t in the time zone of ip, see Record.Location
+func (db *DB) LocalTime(ip string, t time.Time) (time.Time, error) {
+	r, err := db.query(ip, ModeCountryShort|ModeLatitude|ModeLongitude|ModeTimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := r.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(loc), nil
+}
+
+// parse a UTC offset such as "+08:00", "-0530" or "UTC+01:00" into seconds east of UTC;
+// ok is false for "-" and empty values
+func parseoffset(s string) (offset int, ok bool, err error) {
+	if s == "" || s == "-" {
+		return 0, false, nil
+	}
+	v := strings.TrimPrefix(strings.TrimPrefix(s, "UTC"), "GMT")
+	if v == "" {
+		return 0, true, nil
+	}
+	sign := 1
+	switch v[0] {
+	case '-':
+		sign = -1
+	case '+':
+	default:
+		return 0, false, fmt.Errorf("%w %q", ErrInvalidTimeZone, s)
+	}
+	hh, mm, found := strings.Cut(v[1:], ":")
+	if !found && len(hh) == 4 {
+		hh, mm = hh[:2], hh[2:]
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil || len(hh) > 2 || h > 14 {
+		return 0, false, fmt.Errorf("%w %q", ErrInvalidTimeZone, s)
+	}
+	var m int
+	if mm != "" {
+		if m, err = strconv.Atoi(mm); err != nil || len(mm) != 2 || m >= 60 {
+			return 0, false, fmt.Errorf("%w %q", ErrInvalidTimeZone, s)
+		}
+	}
+	return sign * (h*3600 + m*60), true, nil
+}
+
+// the IANA zone of r, or nil
+func (r *Record) ianazone(offset int, hasoffset bool) *time.Location {
+	lat, lon, hascoords := r.Coordinates()
+	var best *time.Location
+	var bestdistance float64
+	ambiguous := false
+	for i := range tzzones {
+		z := &tzzones[i]
+		if !strings.EqualFold(z.country, r.CountryShort) {
+			continue
+		}
+		loc := loadzone(z.name)
+		if loc == nil || hasoffset && !hasutcoffset(loc, offset) {
+			continue
+		}
+		d := haversine(lat, lon, z.latitude, z.longitude)
+		switch {
+		case best == nil:
+			best, bestdistance = loc, d
+		case hascoords:
+			if d < bestdistance {
+				best, bestdistance = loc, d
+			}
+		case !sameoffsets(best, loc):
+			ambiguous = true
+		}
+	}
+	if ambiguous {
+		return nil
+	}
+	return best
+}
+
+// time.LoadLocation, cached
+func loadzone(name string) *time.Location {
+	if loc, ok := tzcache.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc = nil
+	}
+	tzcache.Store(name, loc)
+	return loc
+}
+
+// whether loc uses offset in winter or summer of this year
+func hasutcoffset(loc *time.Location, offset int) bool {
+	jan, jul := offsets(loc)
+	return jan == offset || jul == offset
+}
+
+// whether a and b have the same offsets this year
+func sameoffsets(a, b *time.Location) bool {
+	ajan, ajul := offsets(a)
+	bjan, bjul := offsets(b)
+	return ajan == bjan && ajul == bjul
+}
+
+// offsets of loc in winter and summer of this year
+func offsets(loc *time.Location) (jan, jul int) {
+	year := time.Now().Year()
+	_, jan = time.Date(year, time.January, 1, 0, 0, 0, 0, loc).Zone()
+	_, jul = time.Date(year, time.July, 1, 0, 0, 0, 0, loc).Zone()
+	return
+}
@@ -0,0 +1,82 @@
+package ip2location_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata" // the IANA zones, whatever the system has
+
+	"github.com/zyxar/ip2location-go"
+)
+
+func TestLocationOffset(t *testing.T) {
+	for _, tt := range []struct {
+		tz     string
+		name   string
+		offset int
+		err    error
+	}{
+		{"+08:00", "UTC+08:00", 8 * 3600, nil},
+		{"-0530", "UTC-0530", -(5*3600 + 30*60), nil},
+		{"+05:45", "UTC+05:45", 5*3600 + 45*60, nil},
+		{"-03:30", "UTC-03:30", -(3*3600 + 30*60), nil},
+		{"+5", "UTC+5", 5 * 3600, nil},
+		{"+14:00", "UTC+14:00", 14 * 3600, nil},
+		{"UTC+01:00", "UTC+01:00", 3600, nil},
+		{"GMT-02:00", "GMT-02:00", -2 * 3600, nil},
+		{"UTC", "UTC", 0, nil},
+		{"-", "", 0, ip2location.ErrInvalidTimeZone},
+		{"", "", 0, ip2location.ErrInvalidTimeZone},
+		{"08:00", "", 0, ip2location.ErrInvalidTimeZone},
+		{"+15:00", "", 0, ip2location.ErrInvalidTimeZone},
+		{"+08:60", "", 0, ip2location.ErrInvalidTimeZone},
+		{"+8:0", "", 0, ip2location.ErrInvalidTimeZone},
+		{"+123", "", 0, ip2location.ErrInvalidTimeZone},
+		{"+ab:00", "", 0, ip2location.ErrInvalidTimeZone},
+	} {
+		// a country without IANA zones, so that the offset makes the zone
+		r := ip2location.Record{CountryShort: "XX", TimeZone: tt.tz}
+		loc, err := r.Location()
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%q: error %v, want %v", tt.tz, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		name, offset := time.Now().In(loc).Zone()
+		if name != tt.name || offset != tt.offset {
+			t.Errorf("%q: got %s %d, want %s %d", tt.tz, name, offset, tt.name, tt.offset)
+		}
+	}
+}
+
+func TestLocationZone(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		country  string
+		tz       string
+		lat, lon float32
+		want     string
+		err      error
+	}{
+		{"single zone", "JP", "+09:00", 0, 0, "Asia/Tokyo", nil},
+		{"single zone, no offset", "JP", "-", 0, 0, "Asia/Tokyo", nil},
+		{"closest", "US", "-05:00", 40.7128, -74.006, "America/New_York", nil},
+		{"closest of the offset", "US", "-06:00", 41.8781, -87.6298, "America/Chicago", nil},
+		{"closest, no offset", "AU", "-", -27.4698, 153.0251, "Australia/Brisbane", nil},
+		{"offset elsewhere in the country", "US", "+09:00", 40.7128, -74.006, "UTC+09:00", nil},
+		{"ambiguous", "US", "-05:00", 0, 0, "UTC-05:00", nil},
+		{"ambiguous, no offset", "US", "-", 0, 0, "", ip2location.ErrInvalidTimeZone},
+	} {
+		r := ip2location.Record{CountryShort: tt.country, TimeZone: tt.tz, Latitude: tt.lat, Longitude: tt.lon}
+		loc, err := r.Location()
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if err == nil && loc.String() != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, loc, tt.want)
+		}
+	}
+}
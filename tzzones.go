@@ -0,0 +1,425 @@
+// Code generated by gen from zone.tab of the tz database, version 2025b; DO NOT EDIT.
+
+package ip2location
+
+// IANA time zones by country, with the coordinates of their principal location
+var tzzones = []tzzone{
+	{"AD", 42.5, 1.5167, "Europe/Andorra"},
+	{"AE", 25.3, 55.3, "Asia/Dubai"},
+	{"AF", 34.5167, 69.2, "Asia/Kabul"},
+	{"AG", 17.05, -61.8, "America/Antigua"},
+	{"AI", 18.2, -63.0667, "America/Anguilla"},
+	{"AL", 41.3333, 19.8333, "Europe/Tirane"},
+	{"AM", 40.1833, 44.5, "Asia/Yerevan"},
+	{"AO", -8.8, 13.2333, "Africa/Luanda"},
+	{"AQ", -77.8333, 166.6, "Antarctica/McMurdo"},
+	{"AQ", -66.2833, 110.5167, "Antarctica/Casey"},
+	{"AQ", -68.5833, 77.9667, "Antarctica/Davis"},
+	{"AQ", -66.6667, 140.0167, "Antarctica/DumontDUrville"},
+	{"AQ", -67.6, 62.8833, "Antarctica/Mawson"},
+	{"AQ", -64.8, -64.1, "Antarctica/Palmer"},
+	{"AQ", -67.5667, -68.1333, "Antarctica/Rothera"},
+	{"AQ", -69.0061, 39.59, "Antarctica/Syowa"},
+	{"AQ", -72.0114, 2.535, "Antarctica/Troll"},
+	{"AQ", -78.4, 106.9, "Antarctica/Vostok"},
+	{"AR", -34.6, -58.45, "America/Argentina/Buenos_Aires"},
+	{"AR", -31.4, -64.1833, "America/Argentina/Cordoba"},
+	{"AR", -24.7833, -65.4167, "America/Argentina/Salta"},
+	{"AR", -24.1833, -65.3, "America/Argentina/Jujuy"},
+	{"AR", -26.8167, -65.2167, "America/Argentina/Tucuman"},
+	{"AR", -28.4667, -65.7833, "America/Argentina/Catamarca"},
+	{"AR", -29.4333, -66.85, "America/Argentina/La_Rioja"},
+	{"AR", -31.5333, -68.5167, "America/Argentina/San_Juan"},
+	{"AR", -32.8833, -68.8167, "America/Argentina/Mendoza"},
+	{"AR", -33.3167, -66.35, "America/Argentina/San_Luis"},
+	{"AR", -51.6333, -69.2167, "America/Argentina/Rio_Gallegos"},
+	{"AR", -54.8, -68.3, "America/Argentina/Ushuaia"},
+	{"AS", -14.2667, -170.7, "Pacific/Pago_Pago"},
+	{"AT", 48.2167, 16.3333, "Europe/Vienna"},
+	{"AU", -31.55, 159.0833, "Australia/Lord_Howe"},
+	{"AU", -54.5, 158.95, "Antarctica/Macquarie"},
+	{"AU", -42.8833, 147.3167, "Australia/Hobart"},
+	{"AU", -37.8167, 144.9667, "Australia/Melbourne"},
+	{"AU", -33.8667, 151.2167, "Australia/Sydney"},
+	{"AU", -31.95, 141.45, "Australia/Broken_Hill"},
+	{"AU", -27.4667, 153.0333, "Australia/Brisbane"},
+	{"AU", -20.2667, 149, "Australia/Lindeman"},
+	{"AU", -34.9167, 138.5833, "Australia/Adelaide"},
+	{"AU", -12.4667, 130.8333, "Australia/Darwin"},
+	{"AU", -31.95, 115.85, "Australia/Perth"},
+	{"AU", -31.7167, 128.8667, "Australia/Eucla"},
+	{"AW", 12.5, -69.9667, "America/Aruba"},
+	{"AX", 60.1, 19.95, "Europe/Mariehamn"},
+	{"AZ", 40.3833, 49.85, "Asia/Baku"},
+	{"BA", 43.8667, 18.4167, "Europe/Sarajevo"},
+	{"BB", 13.1, -59.6167, "America/Barbados"},
+	{"BD", 23.7167, 90.4167, "Asia/Dhaka"},
+	{"BE", 50.8333, 4.3333, "Europe/Brussels"},
+	{"BF", 12.3667, -1.5167, "Africa/Ouagadougou"},
+	{"BG", 42.6833, 23.3167, "Europe/Sofia"},
+	{"BH", 26.3833, 50.5833, "Asia/Bahrain"},
+	{"BI", -3.3833, 29.3667, "Africa/Bujumbura"},
+	{"BJ", 6.4833, 2.6167, "Africa/Porto-Novo"},
+	{"BL", 17.8833, -62.85, "America/St_Barthelemy"},
+	{"BM", 32.2833, -64.7667, "Atlantic/Bermuda"},
+	{"BN", 4.9333, 114.9167, "Asia/Brunei"},
+	{"BO", -16.5, -68.15, "America/La_Paz"},
+	{"BQ", 12.1508, -68.2767, "America/Kralendijk"},
+	{"BR", -3.85, -32.4167, "America/Noronha"},
+	{"BR", -1.45, -48.4833, "America/Belem"},
+	{"BR", -3.7167, -38.5, "America/Fortaleza"},
+	{"BR", -8.05, -34.9, "America/Recife"},
+	{"BR", -7.2, -48.2, "America/Araguaina"},
+	{"BR", -9.6667, -35.7167, "America/Maceio"},
+	{"BR", -12.9833, -38.5167, "America/Bahia"},
+	{"BR", -23.5333, -46.6167, "America/Sao_Paulo"},
+	{"BR", -20.45, -54.6167, "America/Campo_Grande"},
+	{"BR", -15.5833, -56.0833, "America/Cuiaba"},
+	{"BR", -2.4333, -54.8667, "America/Santarem"},
+	{"BR", -8.7667, -63.9, "America/Porto_Velho"},
+	{"BR", 2.8167, -60.6667, "America/Boa_Vista"},
+	{"BR", -3.1333, -60.0167, "America/Manaus"},
+	{"BR", -6.6667, -69.8667, "America/Eirunepe"},
+	{"BR", -9.9667, -67.8, "America/Rio_Branco"},
+	{"BS", 25.0833, -77.35, "America/Nassau"},
+	{"BT", 27.4667, 89.65, "Asia/Thimphu"},
+	{"BW", -24.65, 25.9167, "Africa/Gaborone"},
+	{"BY", 53.9, 27.5667, "Europe/Minsk"},
+	{"BZ", 17.5, -88.2, "America/Belize"},
+	{"CA", 47.5667, -52.7167, "America/St_Johns"},
+	{"CA", 44.65, -63.6, "America/Halifax"},
+	{"CA", 46.2, -59.95, "America/Glace_Bay"},
+	{"CA", 46.1, -64.7833, "America/Moncton"},
+	{"CA", 53.3333, -60.4167, "America/Goose_Bay"},
+	{"CA", 51.4167, -57.1167, "America/Blanc-Sablon"},
+	{"CA", 43.65, -79.3833, "America/Toronto"},
+	{"CA", 63.7333, -68.4667, "America/Iqaluit"},
+	{"CA", 48.7586, -91.6217, "America/Atikokan"},
+	{"CA", 49.8833, -97.15, "America/Winnipeg"},
+	{"CA", 74.6956, -94.8292, "America/Resolute"},
+	{"CA", 62.8167, -92.0831, "America/Rankin_Inlet"},
+	{"CA", 50.4, -104.65, "America/Regina"},
+	{"CA", 50.2833, -107.8333, "America/Swift_Current"},
+	{"CA", 53.55, -113.4667, "America/Edmonton"},
+	{"CA", 69.1139, -105.0528, "America/Cambridge_Bay"},
+	{"CA", 68.3497, -133.7167, "America/Inuvik"},
+	{"CA", 49.1, -116.5167, "America/Creston"},
+	{"CA", 55.7667, -120.2333, "America/Dawson_Creek"},
+	{"CA", 58.8, -122.7, "America/Fort_Nelson"},
+	{"CA", 60.7167, -135.05, "America/Whitehorse"},
+	{"CA", 64.0667, -139.4167, "America/Dawson"},
+	{"CA", 49.2667, -123.1167, "America/Vancouver"},
+	{"CC", -12.1667, 96.9167, "Indian/Cocos"},
+	{"CD", -4.3, 15.3, "Africa/Kinshasa"},
+	{"CD", -11.6667, 27.4667, "Africa/Lubumbashi"},
+	{"CF", 4.3667, 18.5833, "Africa/Bangui"},
+	{"CG", -4.2667, 15.2833, "Africa/Brazzaville"},
+	{"CH", 47.3833, 8.5333, "Europe/Zurich"},
+	{"CI", 5.3167, -4.0333, "Africa/Abidjan"},
+	{"CK", -21.2333, -159.7667, "Pacific/Rarotonga"},
+	{"CL", -33.45, -70.6667, "America/Santiago"},
+	{"CL", -45.5667, -72.0667, "America/Coyhaique"},
+	{"CL", -53.15, -70.9167, "America/Punta_Arenas"},
+	{"CL", -27.15, -109.4333, "Pacific/Easter"},
+	{"CM", 4.05, 9.7, "Africa/Douala"},
+	{"CN", 31.2333, 121.4667, "Asia/Shanghai"},
+	{"CN", 43.8, 87.5833, "Asia/Urumqi"},
+	{"CO", 4.6, -74.0833, "America/Bogota"},
+	{"CR", 9.9333, -84.0833, "America/Costa_Rica"},
+	{"CU", 23.1333, -82.3667, "America/Havana"},
+	{"CV", 14.9167, -23.5167, "Atlantic/Cape_Verde"},
+	{"CW", 12.1833, -69, "America/Curacao"},
+	{"CX", -10.4167, 105.7167, "Indian/Christmas"},
+	{"CY", 35.1667, 33.3667, "Asia/Nicosia"},
+	{"CY", 35.1167, 33.95, "Asia/Famagusta"},
+	{"CZ", 50.0833, 14.4333, "Europe/Prague"},
+	{"DE", 52.5, 13.3667, "Europe/Berlin"},
+	{"DE", 47.7, 8.6833, "Europe/Busingen"},
+	{"DJ", 11.6, 43.15, "Africa/Djibouti"},
+	{"DK", 55.6667, 12.5833, "Europe/Copenhagen"},
+	{"DM", 15.3, -61.4, "America/Dominica"},
+	{"DO", 18.4667, -69.9, "America/Santo_Domingo"},
+	{"DZ", 36.7833, 3.05, "Africa/Algiers"},
+	{"EC", -2.1667, -79.8333, "America/Guayaquil"},
+	{"EC", -0.9, -89.6, "Pacific/Galapagos"},
+	{"EE", 59.4167, 24.75, "Europe/Tallinn"},
+	{"EG", 30.05, 31.25, "Africa/Cairo"},
+	{"EH", 27.15, -13.2, "Africa/El_Aaiun"},
+	{"ER", 15.3333, 38.8833, "Africa/Asmara"},
+	{"ES", 40.4, -3.6833, "Europe/Madrid"},
+	{"ES", 35.8833, -5.3167, "Africa/Ceuta"},
+	{"ES", 28.1, -15.4, "Atlantic/Canary"},
+	{"ET", 9.0333, 38.7, "Africa/Addis_Ababa"},
+	{"FI", 60.1667, 24.9667, "Europe/Helsinki"},
+	{"FJ", -18.1333, 178.4167, "Pacific/Fiji"},
+	{"FK", -51.7, -57.85, "Atlantic/Stanley"},
+	{"FM", 7.4167, 151.7833, "Pacific/Chuuk"},
+	{"FM", 6.9667, 158.2167, "Pacific/Pohnpei"},
+	{"FM", 5.3167, 162.9833, "Pacific/Kosrae"},
+	{"FO", 62.0167, -6.7667, "Atlantic/Faroe"},
+	{"FR", 48.8667, 2.3333, "Europe/Paris"},
+	{"GA", 0.3833, 9.45, "Africa/Libreville"},
+	{"GB", 51.5083, -0.1253, "Europe/London"},
+	{"GD", 12.05, -61.75, "America/Grenada"},
+	{"GE", 41.7167, 44.8167, "Asia/Tbilisi"},
+	{"GF", 4.9333, -52.3333, "America/Cayenne"},
+	{"GG", 49.4547, -2.5361, "Europe/Guernsey"},
+	{"GH", 5.55, -0.2167, "Africa/Accra"},
+	{"GI", 36.1333, -5.35, "Europe/Gibraltar"},
+	{"GL", 64.1833, -51.7333, "America/Nuuk"},
+	{"GL", 76.7667, -18.6667, "America/Danmarkshavn"},
+	{"GL", 70.4833, -21.9667, "America/Scoresbysund"},
+	{"GL", 76.5667, -68.7833, "America/Thule"},
+	{"GM", 13.4667, -16.65, "Africa/Banjul"},
+	{"GN", 9.5167, -13.7167, "Africa/Conakry"},
+	{"GP", 16.2333, -61.5333, "America/Guadeloupe"},
+	{"GQ", 3.75, 8.7833, "Africa/Malabo"},
+	{"GR", 37.9667, 23.7167, "Europe/Athens"},
+	{"GS", -54.2667, -36.5333, "Atlantic/South_Georgia"},
+	{"GT", 14.6333, -90.5167, "America/Guatemala"},
+	{"GU", 13.4667, 144.75, "Pacific/Guam"},
+	{"GW", 11.85, -15.5833, "Africa/Bissau"},
+	{"GY", 6.8, -58.1667, "America/Guyana"},
+	{"HK", 22.2833, 114.15, "Asia/Hong_Kong"},
+	{"HN", 14.1, -87.2167, "America/Tegucigalpa"},
+	{"HR", 45.8, 15.9667, "Europe/Zagreb"},
+	{"HT", 18.5333, -72.3333, "America/Port-au-Prince"},
+	{"HU", 47.5, 19.0833, "Europe/Budapest"},
+	{"ID", -6.1667, 106.8, "Asia/Jakarta"},
+	{"ID", -0.0333, 109.3333, "Asia/Pontianak"},
+	{"ID", -5.1167, 119.4, "Asia/Makassar"},
+	{"ID", -2.5333, 140.7, "Asia/Jayapura"},
+	{"IE", 53.3333, -6.25, "Europe/Dublin"},
+	{"IL", 31.7806, 35.2239, "Asia/Jerusalem"},
+	{"IM", 54.15, -4.4667, "Europe/Isle_of_Man"},
+	{"IN", 22.5333, 88.3667, "Asia/Kolkata"},
+	{"IO", -7.3333, 72.4167, "Indian/Chagos"},
+	{"IQ", 33.35, 44.4167, "Asia/Baghdad"},
+	{"IR", 35.6667, 51.4333, "Asia/Tehran"},
+	{"IS", 64.15, -21.85, "Atlantic/Reykjavik"},
+	{"IT", 41.9, 12.4833, "Europe/Rome"},
+	{"JE", 49.1836, -2.1067, "Europe/Jersey"},
+	{"JM", 17.9681, -76.7933, "America/Jamaica"},
+	{"JO", 31.95, 35.9333, "Asia/Amman"},
+	{"JP", 35.6544, 139.7447, "Asia/Tokyo"},
+	{"KE", -1.2833, 36.8167, "Africa/Nairobi"},
+	{"KG", 42.9, 74.6, "Asia/Bishkek"},
+	{"KH", 11.55, 104.9167, "Asia/Phnom_Penh"},
+	{"KI", 1.4167, 173, "Pacific/Tarawa"},
+	{"KI", -2.7833, -171.7167, "Pacific/Kanton"},
+	{"KI", 1.8667, -157.3333, "Pacific/Kiritimati"},
+	{"KM", -11.6833, 43.2667, "Indian/Comoro"},
+	{"KN", 17.3, -62.7167, "America/St_Kitts"},
+	{"KP", 39.0167, 125.75, "Asia/Pyongyang"},
+	{"KR", 37.55, 126.9667, "Asia/Seoul"},
+	{"KW", 29.3333, 47.9833, "Asia/Kuwait"},
+	{"KY", 19.3, -81.3833, "America/Cayman"},
+	{"KZ", 43.25, 76.95, "Asia/Almaty"},
+	{"KZ", 44.8, 65.4667, "Asia/Qyzylorda"},
+	{"KZ", 53.2, 63.6167, "Asia/Qostanay"},
+	{"KZ", 50.2833, 57.1667, "Asia/Aqtobe"},
+	{"KZ", 44.5167, 50.2667, "Asia/Aqtau"},
+	{"KZ", 47.1167, 51.9333, "Asia/Atyrau"},
+	{"KZ", 51.2167, 51.35, "Asia/Oral"},
+	{"LA", 17.9667, 102.6, "Asia/Vientiane"},
+	{"LB", 33.8833, 35.5, "Asia/Beirut"},
+	{"LC", 14.0167, -61, "America/St_Lucia"},
+	{"LI", 47.15, 9.5167, "Europe/Vaduz"},
+	{"LK", 6.9333, 79.85, "Asia/Colombo"},
+	{"LR", 6.3, -10.7833, "Africa/Monrovia"},
+	{"LS", -29.4667, 27.5, "Africa/Maseru"},
+	{"LT", 54.6833, 25.3167, "Europe/Vilnius"},
+	{"LU", 49.6, 6.15, "Europe/Luxembourg"},
+	{"LV", 56.95, 24.1, "Europe/Riga"},
+	{"LY", 32.9, 13.1833, "Africa/Tripoli"},
+	{"MA", 33.65, -7.5833, "Africa/Casablanca"},
+	{"MC", 43.7, 7.3833, "Europe/Monaco"},
+	{"MD", 47, 28.8333, "Europe/Chisinau"},
+	{"ME", 42.4333, 19.2667, "Europe/Podgorica"},
+	{"MF", 18.0667, -63.0833, "America/Marigot"},
+	{"MG", -18.9167, 47.5167, "Indian/Antananarivo"},
+	{"MH", 7.15, 171.2, "Pacific/Majuro"},
+	{"MH", 9.0833, 167.3333, "Pacific/Kwajalein"},
+	{"MK", 41.9833, 21.4333, "Europe/Skopje"},
+	{"ML", 12.65, -8, "Africa/Bamako"},
+	{"MM", 16.7833, 96.1667, "Asia/Yangon"},
+	{"MN", 47.9167, 106.8833, "Asia/Ulaanbaatar"},
+	{"MN", 48.0167, 91.65, "Asia/Hovd"},
+	{"MO", 22.1972, 113.5417, "Asia/Macau"},
+	{"MP", 15.2, 145.75, "Pacific/Saipan"},
+	{"MQ", 14.6, -61.0833, "America/Martinique"},
+	{"MR", 18.1, -15.95, "Africa/Nouakchott"},
+	{"MS", 16.7167, -62.2167, "America/Montserrat"},
+	{"MT", 35.9, 14.5167, "Europe/Malta"},
+	{"MU", -20.1667, 57.5, "Indian/Mauritius"},
+	{"MV", 4.1667, 73.5, "Indian/Maldives"},
+	{"MW", -15.7833, 35, "Africa/Blantyre"},
+	{"MX", 19.4, -99.15, "America/Mexico_City"},
+	{"MX", 21.0833, -86.7667, "America/Cancun"},
+	{"MX", 20.9667, -89.6167, "America/Merida"},
+	{"MX", 25.6667, -100.3167, "America/Monterrey"},
+	{"MX", 25.8333, -97.5, "America/Matamoros"},
+	{"MX", 28.6333, -106.0833, "America/Chihuahua"},
+	{"MX", 31.7333, -106.4833, "America/Ciudad_Juarez"},
+	{"MX", 29.5667, -104.4167, "America/Ojinaga"},
+	{"MX", 23.2167, -106.4167, "America/Mazatlan"},
+	{"MX", 20.8, -105.25, "America/Bahia_Banderas"},
+	{"MX", 29.0667, -110.9667, "America/Hermosillo"},
+	{"MX", 32.5333, -117.0167, "America/Tijuana"},
+	{"MY", 3.1667, 101.7, "Asia/Kuala_Lumpur"},
+	{"MY", 1.55, 110.3333, "Asia/Kuching"},
+	{"MZ", -25.9667, 32.5833, "Africa/Maputo"},
+	{"NA", -22.5667, 17.1, "Africa/Windhoek"},
+	{"NC", -22.2667, 166.45, "Pacific/Noumea"},
+	{"NE", 13.5167, 2.1167, "Africa/Niamey"},
+	{"NF", -29.05, 167.9667, "Pacific/Norfolk"},
+	{"NG", 6.45, 3.4, "Africa/Lagos"},
+	{"NI", 12.15, -86.2833, "America/Managua"},
+	{"NL", 52.3667, 4.9, "Europe/Amsterdam"},
+	{"NO", 59.9167, 10.75, "Europe/Oslo"},
+	{"NP", 27.7167, 85.3167, "Asia/Kathmandu"},
+	{"NR", -0.5167, 166.9167, "Pacific/Nauru"},
+	{"NU", -19.0167, -169.9167, "Pacific/Niue"},
+	{"NZ", -36.8667, 174.7667, "Pacific/Auckland"},
+	{"NZ", -43.95, -176.55, "Pacific/Chatham"},
+	{"OM", 23.6, 58.5833, "Asia/Muscat"},
+	{"PA", 8.9667, -79.5333, "America/Panama"},
+	{"PE", -12.05, -77.05, "America/Lima"},
+	{"PF", -17.5333, -149.5667, "Pacific/Tahiti"},
+	{"PF", -9, -139.5, "Pacific/Marquesas"},
+	{"PF", -23.1333, -134.95, "Pacific/Gambier"},
+	{"PG", -9.5, 147.1667, "Pacific/Port_Moresby"},
+	{"PG", -6.2167, 155.5667, "Pacific/Bougainville"},
+	{"PH", 14.5867, 120.9678, "Asia/Manila"},
+	{"PK", 24.8667, 67.05, "Asia/Karachi"},
+	{"PL", 52.25, 21, "Europe/Warsaw"},
+	{"PM", 47.05, -56.3333, "America/Miquelon"},
+	{"PN", -25.0667, -130.0833, "Pacific/Pitcairn"},
+	{"PR", 18.4683, -66.1061, "America/Puerto_Rico"},
+	{"PS", 31.5, 34.4667, "Asia/Gaza"},
+	{"PS", 31.5333, 35.095, "Asia/Hebron"},
+	{"PT", 38.7167, -9.1333, "Europe/Lisbon"},
+	{"PT", 32.6333, -16.9, "Atlantic/Madeira"},
+	{"PT", 37.7333, -25.6667, "Atlantic/Azores"},
+	{"PW", 7.3333, 134.4833, "Pacific/Palau"},
+	{"PY", -25.2667, -57.6667, "America/Asuncion"},
+	{"QA", 25.2833, 51.5333, "Asia/Qatar"},
+	{"RE", -20.8667, 55.4667, "Indian/Reunion"},
+	{"RO", 44.4333, 26.1, "Europe/Bucharest"},
+	{"RS", 44.8333, 20.5, "Europe/Belgrade"},
+	{"RU", 54.7167, 20.5, "Europe/Kaliningrad"},
+	{"RU", 55.7558, 37.6178, "Europe/Moscow"},
+	{"UA", 44.95, 34.1, "Europe/Simferopol"},
+	{"RU", 58.6, 49.65, "Europe/Kirov"},
+	{"RU", 48.7333, 44.4167, "Europe/Volgograd"},
+	{"RU", 46.35, 48.05, "Europe/Astrakhan"},
+	{"RU", 51.5667, 46.0333, "Europe/Saratov"},
+	{"RU", 54.3333, 48.4, "Europe/Ulyanovsk"},
+	{"RU", 53.2, 50.15, "Europe/Samara"},
+	{"RU", 56.85, 60.6, "Asia/Yekaterinburg"},
+	{"RU", 55, 73.4, "Asia/Omsk"},
+	{"RU", 55.0333, 82.9167, "Asia/Novosibirsk"},
+	{"RU", 53.3667, 83.75, "Asia/Barnaul"},
+	{"RU", 56.5, 84.9667, "Asia/Tomsk"},
+	{"RU", 53.75, 87.1167, "Asia/Novokuznetsk"},
+	{"RU", 56.0167, 92.8333, "Asia/Krasnoyarsk"},
+	{"RU", 52.2667, 104.3333, "Asia/Irkutsk"},
+	{"RU", 52.05, 113.4667, "Asia/Chita"},
+	{"RU", 62, 129.6667, "Asia/Yakutsk"},
+	{"RU", 62.6564, 135.5539, "Asia/Khandyga"},
+	{"RU", 43.1667, 131.9333, "Asia/Vladivostok"},
+	{"RU", 64.5603, 143.2267, "Asia/Ust-Nera"},
+	{"RU", 59.5667, 150.8, "Asia/Magadan"},
+	{"RU", 46.9667, 142.7, "Asia/Sakhalin"},
+	{"RU", 67.4667, 153.7167, "Asia/Srednekolymsk"},
+	{"RU", 53.0167, 158.65, "Asia/Kamchatka"},
+	{"RU", 64.75, 177.4833, "Asia/Anadyr"},
+	{"RW", -1.95, 30.0667, "Africa/Kigali"},
+	{"SA", 24.6333, 46.7167, "Asia/Riyadh"},
+	{"SB", -9.5333, 160.2, "Pacific/Guadalcanal"},
+	{"SC", -4.6667, 55.4667, "Indian/Mahe"},
+	{"SD", 15.6, 32.5333, "Africa/Khartoum"},
+	{"SE", 59.3333, 18.05, "Europe/Stockholm"},
+	{"SG", 1.2833, 103.85, "Asia/Singapore"},
+	{"SH", -15.9167, -5.7, "Atlantic/St_Helena"},
+	{"SI", 46.05, 14.5167, "Europe/Ljubljana"},
+	{"SJ", 78, 16, "Arctic/Longyearbyen"},
+	{"SK", 48.15, 17.1167, "Europe/Bratislava"},
+	{"SL", 8.5, -13.25, "Africa/Freetown"},
+	{"SM", 43.9167, 12.4667, "Europe/San_Marino"},
+	{"SN", 14.6667, -17.4333, "Africa/Dakar"},
+	{"SO", 2.0667, 45.3667, "Africa/Mogadishu"},
+	{"SR", 5.8333, -55.1667, "America/Paramaribo"},
+	{"SS", 4.85, 31.6167, "Africa/Juba"},
+	{"ST", 0.3333, 6.7333, "Africa/Sao_Tome"},
+	{"SV", 13.7, -89.2, "America/El_Salvador"},
+	{"SX", 18.0514, -63.0472, "America/Lower_Princes"},
+	{"SY", 33.5, 36.3, "Asia/Damascus"},
+	{"SZ", -26.3, 31.1, "Africa/Mbabane"},
+	{"TC", 21.4667, -71.1333, "America/Grand_Turk"},
+	{"TD", 12.1167, 15.05, "Africa/Ndjamena"},
+	{"TF", -49.3528, 70.2175, "Indian/Kerguelen"},
+	{"TG", 6.1333, 1.2167, "Africa/Lome"},
+	{"TH", 13.75, 100.5167, "Asia/Bangkok"},
+	{"TJ", 38.5833, 68.8, "Asia/Dushanbe"},
+	{"TK", -9.3667, -171.2333, "Pacific/Fakaofo"},
+	{"TL", -8.55, 125.5833, "Asia/Dili"},
+	{"TM", 37.95, 58.3833, "Asia/Ashgabat"},
+	{"TN", 36.8, 10.1833, "Africa/Tunis"},
+	{"TO", -21.1333, -175.2, "Pacific/Tongatapu"},
+	{"TR", 41.0167, 28.9667, "Europe/Istanbul"},
+	{"TT", 10.65, -61.5167, "America/Port_of_Spain"},
+	{"TV", -8.5167, 179.2167, "Pacific/Funafuti"},
+	{"TW", 25.05, 121.5, "Asia/Taipei"},
+	{"TZ", -6.8, 39.2833, "Africa/Dar_es_Salaam"},
+	{"UA", 50.4333, 30.5167, "Europe/Kyiv"},
+	{"UG", 0.3167, 32.4167, "Africa/Kampala"},
+	{"UM", 28.2167, -177.3667, "Pacific/Midway"},
+	{"UM", 19.2833, 166.6167, "Pacific/Wake"},
+	{"US", 40.7142, -74.0064, "America/New_York"},
+	{"US", 42.3314, -83.0458, "America/Detroit"},
+	{"US", 38.2542, -85.7594, "America/Kentucky/Louisville"},
+	{"US", 36.8297, -84.8492, "America/Kentucky/Monticello"},
+	{"US", 39.7683, -86.1581, "America/Indiana/Indianapolis"},
+	{"US", 38.6772, -87.5286, "America/Indiana/Vincennes"},
+	{"US", 41.0514, -86.6031, "America/Indiana/Winamac"},
+	{"US", 38.3756, -86.3447, "America/Indiana/Marengo"},
+	{"US", 38.4919, -87.2786, "America/Indiana/Petersburg"},
+	{"US", 38.7478, -85.0672, "America/Indiana/Vevay"},
+	{"US", 41.85, -87.65, "America/Chicago"},
+	{"US", 37.9531, -86.7614, "America/Indiana/Tell_City"},
+	{"US", 41.2958, -86.625, "America/Indiana/Knox"},
+	{"US", 45.1078, -87.6142, "America/Menominee"},
+	{"US", 47.1164, -101.2992, "America/North_Dakota/Center"},
+	{"US", 46.845, -101.4108, "America/North_Dakota/New_Salem"},
+	{"US", 47.2642, -101.7778, "America/North_Dakota/Beulah"},
+	{"US", 39.7392, -104.9842, "America/Denver"},
+	{"US", 43.6136, -116.2025, "America/Boise"},
+	{"US", 33.4483, -112.0733, "America/Phoenix"},
+	{"US", 34.0522, -118.2428, "America/Los_Angeles"},
+	{"US", 61.2181, -149.9003, "America/Anchorage"},
+	{"US", 58.3019, -134.4197, "America/Juneau"},
+	{"US", 57.1764, -135.3019, "America/Sitka"},
+	{"US", 55.1269, -131.5764, "America/Metlakatla"},
+	{"US", 59.5469, -139.7272, "America/Yakutat"},
+	{"US", 64.5011, -165.4064, "America/Nome"},
+	{"US", 51.88, -176.6581, "America/Adak"},
+	{"US", 21.3069, -157.8583, "Pacific/Honolulu"},
+	{"UY", -34.9092, -56.2125, "America/Montevideo"},
+	{"UZ", 39.6667, 66.8, "Asia/Samarkand"},
+	{"UZ", 41.3333, 69.3, "Asia/Tashkent"},
+	{"VA", 41.9022, 12.4531, "Europe/Vatican"},
+	{"VC", 13.15, -61.2333, "America/St_Vincent"},
+	{"VE", 10.5, -66.9333, "America/Caracas"},
+	{"VG", 18.45, -64.6167, "America/Tortola"},
+	{"VI", 18.35, -64.9333, "America/St_Thomas"},
+	{"VN", 10.75, 106.6667, "Asia/Ho_Chi_Minh"},
+	{"VU", -17.6667, 168.4167, "Pacific/Efate"},
+	{"WF", -13.3, -176.1667, "Pacific/Wallis"},
+	{"WS", -13.8333, -171.7333, "Pacific/Apia"},
+	{"YE", 12.75, 45.2, "Asia/Aden"},
+	{"YT", -12.7833, 45.2333, "Indian/Mayotte"},
+	{"ZA", -26.25, 28, "Africa/Johannesburg"},
+	{"ZM", -15.4167, 28.2833, "Africa/Lusaka"},
+	{"ZW", -17.8333, 31.05, "Africa/Harare"},
+}
@@ -0,0 +1,83 @@
+package ip2location
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidUsageType = errors.New("Invalid usage type")
+
+// UsageType is a set of the usage type codes of IP2Location databases
+type UsageType uint16
+
+const (
+	UsageCommercial   UsageType = 1 << iota // COM: commercial
+	UsageOrganization                       // ORG: organization
+	UsageGovernment                         // GOV: government
+	UsageMilitary                           // MIL: military
+	UsageEducation                          // EDU: university, college, school
+	UsageLibrary                            // LIB: library
+	UsageCDN                                // CDN: content delivery network
+	UsageISP                                // ISP: fixed line ISP
+	UsageMobile                             // MOB: mobile ISP
+	UsageDataCenter                         // DCH: data center, web hosting, transit
+	UsageSearchEngine                       // SES: search engine spider
+	UsageReserved                           // RSV: reserved
+)
+
+// codes of the usage types, in bit order
+var usagecodes = [...]string{"COM", "ORG", "GOV", "MIL", "EDU", "LIB", "CDN", "ISP", "MOB", "DCH", "SES", "RSV"}
+
+// ParseUsageType parses a usage type such as "DCH", or a composite such as "ISP/MOB";
+// "" and "-" parse as no usage type
+func ParseUsageType(s string) (UsageType, error) {
+	if s == "" || s == "-" {
+		return 0, nil
+	}
+	var u UsageType
+	for _, code := range strings.Split(s, "/") {
+		code = strings.TrimSpace(code)
+		i := 0
+		for i < len(usagecodes) && !strings.EqualFold(usagecodes[i], code) {
+			i++
+		}
+		if i == len(usagecodes) {
+			return 0, fmt.Errorf("%w %q", ErrInvalidUsageType, code)
+		}
+		u |= 1 << i
+	}
+	return u, nil
+}
+
+// String returns the codes of u joined by '/', e.g. "ISP/MOB", or "-" if u is empty
+func (u UsageType) String() string {
+	var codes []string
+	for i, code := range usagecodes {
+		if u&(1<<i) != 0 {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return "-"
+	}
+	return strings.Join(codes, "/")
+}
+
+// Has reports whether u includes every usage type of v
+func (u UsageType) Has(v UsageType) bool { return u&v == v && v != 0 }
+
+// IsDataCenter reports whether u includes data center, web hosting or transit (DCH)
+func (u UsageType) IsDataCenter() bool { return u&UsageDataCenter != 0 }
+
+// IsMobile reports whether u includes mobile ISP (MOB)
+func (u UsageType) IsMobile() bool { return u&UsageMobile != 0 }
+
+// IsSearchEngine reports whether u includes search engine spider (SES)
+func (u UsageType) IsSearchEngine() bool { return u&UsageSearchEngine != 0 }
+
+// Usage parses r.UsageType
+func (r *Record) Usage() (UsageType, error) { return ParseUsageType(r.UsageType) }
+
+// Usage parses r.UsageType
+func (r *ProxyRecord) Usage() (UsageType, error) { return ParseUsageType(r.UsageType) }
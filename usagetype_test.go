@@ -0,0 +1,57 @@
+package ip2location_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zyxar/ip2location-go"
+)
+
+func TestParseUsageType(t *testing.T) {
+	for _, tt := range []struct {
+		in     string
+		want   ip2location.UsageType
+		str    string
+		mobile bool
+		dch    bool
+		err    error
+	}{
+		{"DCH", ip2location.UsageDataCenter, "DCH", false, true, nil},
+		{"ISP/MOB", ip2location.UsageISP | ip2location.UsageMobile, "ISP/MOB", true, false, nil},
+		{"MOB/ISP", ip2location.UsageISP | ip2location.UsageMobile, "ISP/MOB", true, false, nil},
+		{"com/org", ip2location.UsageCommercial | ip2location.UsageOrganization, "COM/ORG", false, false, nil},
+		{"EDU / LIB", ip2location.UsageEducation | ip2location.UsageLibrary, "EDU/LIB", false, false, nil},
+		{"CDN/DCH", ip2location.UsageCDN | ip2location.UsageDataCenter, "CDN/DCH", false, true, nil},
+		{"-", 0, "-", false, false, nil},
+		{"", 0, "-", false, false, nil},
+		{"ISP/XYZ", 0, "-", false, false, ip2location.ErrInvalidUsageType},
+		{"ISP/", 0, "-", false, false, ip2location.ErrInvalidUsageType},
+		{"ISP,MOB", 0, "-", false, false, ip2location.ErrInvalidUsageType},
+	} {
+		got, err := ip2location.ParseUsageType(tt.in)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("%q: got %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.err)
+			continue
+		}
+		if got.String() != tt.str || got.IsMobile() != tt.mobile || got.IsDataCenter() != tt.dch {
+			t.Errorf("%q: String %q IsMobile %v IsDataCenter %v, want %q %v %v", tt.in, got, got.IsMobile(), got.IsDataCenter(), tt.str, tt.mobile, tt.dch)
+		}
+	}
+}
+
+func TestUsageTypeHas(t *testing.T) {
+	u := ip2location.UsageISP | ip2location.UsageMobile
+	for _, tt := range []struct {
+		v    ip2location.UsageType
+		want bool
+	}{
+		{ip2location.UsageISP, true},
+		{ip2location.UsageISP | ip2location.UsageMobile, true},
+		{ip2location.UsageISP | ip2location.UsageDataCenter, false},
+		{0, false},
+	} {
+		if got := u.Has(tt.v); got != tt.want {
+			t.Errorf("%v.Has(%v): got %v, want %v", u, tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,459 @@
+package ip2location
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrOverlap       = errors.New("Overlapping ranges")
+	ErrInvalidRecord = errors.New("Invalid record")
+)
+
+const (
+	headersize = 64        // bytes before the first index
+	indexsize  = 65536 * 8 // low and high row for each value of the first 16 bits
+)
+
+// Writer builds a database file from ranges and records.
+//
+//	w, _ := ip2location.NewWriter(3, time.Now())
+//	w.AddPrefix(netip.MustParsePrefix("10.0.0.0/8"), &ip2location.Record{CountryShort: "US", ...})
+//	w.WriteTo(f)
+//
+// Addresses not covered by any range map to an empty Record, and adjacent
+// ranges with the same fields are merged.
+type Writer struct {
+	dbtype uint8
+	date   time.Time
+	mode   uint32
+	ranges [2][]Range // IPv4 and IPv6 rows as added
+}
+
+// NewWriter returns a Writer of database type `dbtype` (1 for DB1 through 26 for DB26), dated `date`
+func NewWriter(dbtype uint8, date time.Time) (*Writer, error) {
+	if dbtype == 0 || int(dbtype) >= len(countryPosition) {
+		return nil, ErrNotSupported
+	}
+	return &Writer{dbtype: dbtype, date: date, mode: dbmode(dbtype)}, nil
+}
+
+// Add maps the addresses [from, to) of family 4 or 6 to rec
+func (w *Writer) Add(family int, from, to *big.Int, rec *Record) error {
+	i, end := familyindex(family)
+	if i < 0 {
+		return ErrInvalidFamily
+	}
+	if from.Sign() < 0 || from.Cmp(to) >= 0 || to.Cmp(end) > 0 {
+		return ErrInvalidAddress
+	}
+	x := *rec
+	w.ranges[i] = append(w.ranges[i], Range{
+		Family: family,
+		From:   new(big.Int).Set(from),
+		To:     new(big.Int).Set(to),
+		Record: &x,
+	})
+	return nil
+}
+
+// AddPrefix maps every address of prefix to rec
+func (w *Writer) AddPrefix(prefix netip.Prefix, rec *Record) error {
+	if !prefix.IsValid() {
+		return ErrInvalidAddress
+	}
+	family := 6
+	if prefix.Addr().Is4() {
+		family = 4
+	}
+	from, to := prefixrange(prefix)
+	return w.Add(family, from, to, rec)
+}
+
+// AddRanges adds every remaining row of it
+func (w *Writer) AddRanges(it *RangeIterator) error {
+	for it.Next() {
+		r := it.Range()
+		if err := w.Add(r.Family, r.From, r.To, r.Record); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// ReadCSV adds the rows of an IP2Location CSV file to the IPv4 (family 4) or IPv6 (family 6) table.
+// Columns are identified by a header line if present ("ip_from", "ip_to", "cidr", "country_code", ...);
+// otherwise the file is expected in the layout of the database type, as written by DB.WriteCSV.
+// Addresses may be given as IP numbers, dotted or colon-hexadecimal addresses; "ip_to" is inclusive.
+func (w *Writer) ReadCSV(r io.Reader, family int) error {
+	if i, _ := familyindex(family); i < 0 {
+		return ErrInvalidFamily
+	}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	var columns []string
+	for line := 1; ; line++ {
+		values, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if columns == nil {
+			if values[0] == "ip_from" || values[0] == "cidr" {
+				columns = append(columns, values...)
+				continue
+			}
+			if strings.Contains(values[0], "/") {
+				columns = []string{"cidr"}
+			} else {
+				columns = []string{"ip_from", "ip_to"}
+			}
+			for _, f := range fields {
+				if w.mode&f.mode != 0 {
+					columns = append(columns, f.name)
+				}
+			}
+		}
+		if err := w.addcsv(family, columns, values); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+// add a CSV line with named columns
+func (w *Writer) addcsv(family int, columns, values []string) error {
+	if len(values) != len(columns) {
+		return ErrInvalidRecord
+	}
+	var rec Record
+	var from, to *big.Int
+	var err error
+	for i, name := range columns {
+		switch name {
+		case "ip_from":
+			from, err = parseipnum(family, values[i])
+		case "ip_to":
+			if to, err = parseipnum(family, values[i]); err == nil {
+				to.Add(to, big.NewInt(1))
+			}
+		case "cidr":
+			var prefix netip.Prefix
+			if prefix, err = netip.ParsePrefix(values[i]); err == nil {
+				if family == 4 && !prefix.Addr().Is4() {
+					return ErrInvalidAddress
+				}
+				if family == 6 && prefix.Addr().Is4() {
+					prefix = netip.PrefixFrom(netip.AddrFrom16(prefix.Addr().As16()), prefix.Bits()+96)
+				}
+				from, to = prefixrange(prefix)
+			}
+		default:
+			if f := fieldbyname(name); f != nil {
+				err = f.set(&rec, values[i])
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if from == nil || to == nil {
+		return ErrInvalidAddress
+	}
+	return w.Add(family, from, to, &rec)
+}
+
+// WriteTo writes the database file to out
+func (w *Writer) WriteTo(out io.Writer) (int64, error) {
+	var rows [2][]Range
+	for i := range rows {
+		if i == 0 || len(w.ranges[i]) > 0 { // the IPv6 table is optional
+			var err error
+			if rows[i], err = w.rows(i); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	columns := uint32(dbcolumns(w.dbtype))
+	colsize := [2]uint32{columns << 2, 16 + ((columns - 1) << 2)}
+
+	// layout: header, indexes, IPv4 and IPv6 tables, then strings; addresses are 1-based
+	var indexaddr, tableaddr [2]uint32
+	pos := uint32(headersize)
+	for i := range rows {
+		if len(rows[i]) > 0 {
+			indexaddr[i] = pos + 1
+			pos += indexsize
+		}
+	}
+	for i := range rows {
+		if len(rows[i]) > 0 {
+			tableaddr[i] = pos + 1
+			pos += uint32(len(rows[i])+1) * colsize[i] // and the row carrying the upper bound
+		}
+	}
+	pool := &stringpool{base: pos, offsets: make(map[string]uint32)}
+	for i := range rows {
+		for _, r := range rows[i] {
+			if _, err := w.cells(pool, r.Record); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	cw := &countwriter{w: out}
+	bw := bufio.NewWriter(cw)
+	var header [headersize]byte
+	header[0] = w.dbtype
+	header[1] = uint8(columns)
+	header[2] = uint8(w.date.Year() % 100)
+	header[3] = uint8(w.date.Month())
+	header[4] = uint8(w.date.Day())
+	binary.LittleEndian.PutUint32(header[5:], uint32(len(rows[0])+1))
+	binary.LittleEndian.PutUint32(header[9:], tableaddr[0])
+	if len(rows[1]) > 0 {
+		binary.LittleEndian.PutUint32(header[13:], uint32(len(rows[1])+1))
+	}
+	binary.LittleEndian.PutUint32(header[17:], tableaddr[1])
+	binary.LittleEndian.PutUint32(header[21:], indexaddr[0])
+	binary.LittleEndian.PutUint32(header[25:], indexaddr[1])
+	header[29] = uint8(ProductIP2Location)
+	binary.LittleEndian.PutUint32(header[31:], pos+uint32(len(pool.data)))
+	bw.Write(header[:])
+
+	for i, family := range []int{4, 6} {
+		if len(rows[i]) > 0 {
+			writeindex(bw, family, rows[i])
+		}
+	}
+	for i, family := range []int{4, 6} {
+		if len(rows[i]) == 0 {
+			continue
+		}
+		var cells []uint32
+		for _, r := range rows[i] {
+			cells, _ = w.cells(pool, r.Record)
+			writerow(bw, family, r.From, cells)
+		}
+		_, maxip := familyindex(family)
+		writerow(bw, family, maxip.Sub(maxip, big.NewInt(1)), cells)
+	}
+	bw.Write(pool.data)
+	if err := bw.Flush(); err != nil {
+		return cw.n, err
+	}
+	return cw.n, nil
+}
+
+// sorted rows of table i covering every address, with gaps filled and equal neighbours merged
+func (w *Writer) rows(i int) ([]Range, error) {
+	ranges := append([]Range(nil), w.ranges[i]...)
+	sort.Slice(ranges, func(a, b int) bool { return ranges[a].From.Cmp(ranges[b].From) < 0 })
+	family := 4
+	if i == 1 {
+		family = 6
+	}
+	_, end := familyindex(family)
+
+	var rows []Range
+	next := big.NewInt(0)
+	for _, r := range ranges {
+		switch r.From.Cmp(next) {
+		case -1:
+			return nil, ErrOverlap
+		case 1:
+			rows = w.appendrow(rows, Range{Family: family, From: next, To: r.From, Record: &Record{}})
+		}
+		rows = w.appendrow(rows, r)
+		next = r.To
+	}
+	if next.Cmp(end) < 0 {
+		rows = w.appendrow(rows, Range{Family: family, From: next, To: end, Record: &Record{}})
+	}
+	return rows, nil
+}
+
+func (w *Writer) appendrow(rows []Range, r Range) []Range {
+	if n := len(rows); n > 0 && samerecord(rows[n-1].Record, r.Record, w.mode) {
+		rows[n-1].To = r.To
+		return rows
+	}
+	return append(rows, r)
+}
+
+// column values of rec after IPFrom, adding its strings to pool
+func (w *Writer) cells(pool *stringpool, rec *Record) ([]uint32, error) {
+	cells := make([]uint32, 0, len(fields))
+	for _, f := range fields {
+		p := f.position[w.dbtype]
+		if p == 0 || f.mode == ModeCountryLong { // country name shares the country column
+			continue
+		}
+		for len(cells) < int(p-1) {
+			cells = append(cells, 0)
+		}
+		var val uint32
+		var err error
+		switch f.mode {
+		case ModeCountryShort:
+			val, err = pool.country(rec.CountryShort, rec.CountryLong)
+		case ModeLatitude:
+			val = math.Float32bits(rec.Latitude)
+		case ModeLongitude:
+			val = math.Float32bits(rec.Longitude)
+		default:
+			val, err = pool.add(f.get(rec))
+		}
+		if err != nil {
+			return nil, err
+		}
+		cells[p-2] = val
+	}
+	return cells, nil
+}
+
+// strings of the database, each prefixed by its length
+type stringpool struct {
+	base    uint32 // file offset of data
+	data    []byte
+	offsets map[string]uint32
+}
+
+func (p *stringpool) add(s string) (uint32, error) {
+	if len(s) > math.MaxUint8 {
+		return 0, ErrInvalidRecord
+	}
+	if off, ok := p.offsets[s]; ok {
+		return off, nil
+	}
+	off := p.base + uint32(len(p.data))
+	p.data = append(append(p.data, uint8(len(s))), s...)
+	p.offsets[s] = off
+	return off, nil
+}
+
+// country code padded to 2 bytes, directly followed by the country name
+func (p *stringpool) country(short, long string) (uint32, error) {
+	if len(short) > 2 || len(long) > math.MaxUint8 {
+		return 0, ErrInvalidRecord
+	}
+	key := "\x00" + short + "\x00" + long
+	if off, ok := p.offsets[key]; ok {
+		return off, nil
+	}
+	off := p.base + uint32(len(p.data))
+	p.data = append(append(p.data, uint8(len(short))), short...)
+	for i := len(short); i < 2; i++ {
+		p.data = append(p.data, 0)
+	}
+	p.data = append(append(p.data, uint8(len(long))), long...)
+	p.offsets[key] = off
+	return off, nil
+}
+
+// write the first and last row for each value of the first 16 bits
+func writeindex(w io.Writer, family int, rows []Range) {
+	shift := uint(112)
+	if family == 4 {
+		shift = 16
+	}
+	var buf [8]byte
+	first, last := new(big.Int), new(big.Int)
+	row := 0
+	for k := int64(0); k < 65536; k++ {
+		first.Lsh(big.NewInt(k), shift)
+		last.Lsh(big.NewInt(k+1), shift)
+		for rows[row].To.Cmp(first) <= 0 {
+			row++
+		}
+		high := row
+		for rows[high].To.Cmp(last) < 0 {
+			high++
+		}
+		binary.LittleEndian.PutUint32(buf[:], uint32(row))
+		binary.LittleEndian.PutUint32(buf[4:], uint32(high))
+		w.Write(buf[:])
+		row = high
+	}
+}
+
+func writerow(w io.Writer, family int, from *big.Int, cells []uint32) {
+	var buf [16]byte
+	if family == 4 {
+		binary.LittleEndian.PutUint32(buf[:], uint32(from.Uint64()))
+		w.Write(buf[:4])
+	} else {
+		from.FillBytes(buf[:])
+		for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+		w.Write(buf[:])
+	}
+	for _, c := range cells {
+		binary.LittleEndian.PutUint32(buf[:], c)
+		w.Write(buf[:4])
+	}
+}
+
+// table index and end of address space (exclusive) of family
+func familyindex(family int) (int, *big.Int) {
+	switch family {
+	case 4:
+		return 0, new(big.Int).Add(maxIPV4Range, big.NewInt(1))
+	case 6:
+		return 1, new(big.Int).Add(maxIPV6Range, big.NewInt(1))
+	}
+	return -1, nil
+}
+
+// parse an IP number or address of family
+func parseipnum(family int, s string) (*big.Int, error) {
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return n, nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	if family == 4 {
+		if !addr.Unmap().Is4() {
+			return nil, ErrInvalidAddress
+		}
+		addr = addr.Unmap()
+	} else {
+		addr = netip.AddrFrom16(addr.As16())
+	}
+	return new(big.Int).SetBytes(addr.AsSlice()), nil
+}
+
+// first and end (exclusive) IP number of prefix
+func prefixrange(prefix netip.Prefix) (*big.Int, *big.Int) {
+	from := new(big.Int).SetBytes(prefix.Masked().Addr().AsSlice())
+	size := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+	return from, size.Add(size, from)
+}
+
+type countwriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countwriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}